@@ -1,9 +1,16 @@
 package channel
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	. "github.com/noxer/nox/dot"
 )
 
+// ErrClosed is returned when reading from a closed channel.
+var ErrClosed = errors.New("channel closed")
+
 // TryGet attempts to read from a channel.
 func TryGet[T any](ch chan T) Optional[T] {
 	select {
@@ -24,6 +31,53 @@ func TryPut[T any](ch chan T, t T) bool {
 	}
 }
 
+// Get reads from a channel, waiting until a value is available or ctx is done.
+// It returns ErrClosed if the channel has been closed and the cause of ctx if
+// it is done.
+func Get[T any](ctx context.Context, ch chan T) Result[T] {
+	select {
+	case t, ok := <-ch:
+		if !ok {
+			return Err[T](ErrClosed)
+		}
+		return OK(t)
+
+	case <-ctx.Done():
+		return Err[T](context.Cause(ctx))
+	}
+}
+
+// GetTimeout reads from a channel, waiting up to d for a value. It returns
+// context.DeadlineExceeded if no value was available in time.
+func GetTimeout[T any](ch chan T, d time.Duration) Result[T] {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	return Get(ctx, ch)
+}
+
+// Put writes into a channel, waiting until there is room or ctx is done. It
+// returns the cause of ctx if it is done. Like any send, Put panics if the
+// channel has been closed.
+func Put[T any](ctx context.Context, ch chan T, t T) error {
+	select {
+	case ch <- t:
+		return nil
+
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// PutTimeout writes into a channel, waiting up to d for room. It returns
+// context.DeadlineExceeded if there was no room in time.
+func PutTimeout[T any](ch chan T, t T, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	return Put(ctx, ch, t)
+}
+
 // Enumerate returns an enumerable from the channel.
 func Enumerate[T any](ch chan T) Enumerable[T] {
 	return &chanEnumerator[T]{ch: ch}
@@ -42,3 +96,42 @@ func (e *chanEnumerator[T]) Next() (ok bool) {
 func (e *chanEnumerator[T]) Value() T {
 	return e.cur
 }
+
+// EnumerateCtx returns an enumerable from the channel that ends when the
+// channel is closed or ctx is done. Its Err method returns the cause of ctx if
+// the enumeration was ended by ctx.
+func EnumerateCtx[T any](ctx context.Context, ch chan T) EnumerableErr[T] {
+	return &chanCtxEnumerator[T]{ctx: ctx, ch: ch}
+}
+
+type chanCtxEnumerator[T any] struct {
+	ctx context.Context
+	ch  chan T
+	cur T
+	err error
+}
+
+func (e *chanCtxEnumerator[T]) Next() bool {
+	if e.err != nil {
+		return false
+	}
+
+	r := Get(e.ctx, e.ch)
+	if !r.Success() {
+		if r.Error() != ErrClosed {
+			e.err = r.Error()
+		}
+		return false
+	}
+
+	e.cur = r.Value()
+	return true
+}
+
+func (e *chanCtxEnumerator[T]) Value() T {
+	return e.cur
+}
+
+func (e *chanCtxEnumerator[T]) Err() error {
+	return e.err
+}
@@ -2,6 +2,8 @@
 package dot
 
 import (
+	"runtime"
+
 	"golang.org/x/exp/constraints"
 )
 
@@ -72,13 +74,16 @@ func (r Result[T]) Error() error {
 }
 
 type noxPanic struct {
-	err error
+	err  error
+	file string
+	line int
 }
 
 // Unwrap returns the returned value or panics if a error was encountered.
 func (r Result[T]) Unwrap() T {
 	if r.err != nil {
-		panic(noxPanic{r.err})
+		_, file, line, _ := runtime.Caller(1)
+		panic(noxPanic{err: r.err, file: file, line: line})
 	}
 
 	return r.val
@@ -153,3 +158,12 @@ type Enumerable[T any] interface {
 	Next() bool
 	Value() T
 }
+
+// EnumerableErr defines an interface for enumerables that can fail while
+// reading, like enumerables backed by I/O. Err returns the error that ended
+// the enumeration, if any. Enumerables holding resources should also
+// implement io.Closer.
+type EnumerableErr[T any] interface {
+	Enumerable[T]
+	Err() error
+}
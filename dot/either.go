@@ -0,0 +1,120 @@
+package dot
+
+import "github.com/noxer/nox/tuple"
+
+// Either holds exactly one of two values, the left or the right one. By
+// convention the right value is the "right" (preferred) one.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates a new Either with the left value set to l.
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{left: l}
+}
+
+// Right creates a new Either with the right value set to r.
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{right: r, isRight: true}
+}
+
+// IsLeft checks if the Either holds the left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight checks if the Either holds the right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns the left value if the Either holds it.
+func (e Either[L, R]) Left() Optional[L] {
+	if e.isRight {
+		return Failure[L]()
+	}
+
+	return Success(e.left)
+}
+
+// Right returns the right value if the Either holds it.
+func (e Either[L, R]) Right() Optional[R] {
+	if e.isRight {
+		return Success(e.right)
+	}
+
+	return Failure[R]()
+}
+
+// Swap turns the left value into the right one and vice versa.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// T2 converts the Either into a tuple of Optionals, only one of which is set.
+func (e Either[L, R]) T2() tuple.T2[Optional[L], Optional[R]] {
+	return tuple.T2[Optional[L], Optional[R]]{A: e.Left(), B: e.Right()}
+}
+
+// FoldEither calls left or right, depending on the value e holds, and returns
+// the result.
+func FoldEither[L, R, T any](e Either[L, R], left func(L) T, right func(R) T) T {
+	if e.isRight {
+		return right(e.right)
+	}
+
+	return left(e.left)
+}
+
+// MapLeft applies f to the left value of e if it holds it.
+func MapLeft[L, R, S any](e Either[L, R], f func(L) S) Either[S, R] {
+	if e.isRight {
+		return Right[S](e.right)
+	}
+
+	return Left[S, R](f(e.left))
+}
+
+// MapRight applies f to the right value of e if it holds it.
+func MapRight[L, R, S any](e Either[L, R], f func(R) S) Either[L, S] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+
+	return Left[L, S](e.left)
+}
+
+// EitherFromResult converts a Result into an Either with the error as the left
+// and the value as the right value.
+func EitherFromResult[T any](r Result[T]) Either[error, T] {
+	if r.err != nil {
+		return Left[error, T](r.err)
+	}
+
+	return Right[error](r.val)
+}
+
+// EitherToResult converts an Either with an error as the left value into a
+// Result.
+func EitherToResult[T any](e Either[error, T]) Result[T] {
+	if e.isRight {
+		return OK(e.right)
+	}
+
+	return Err[T](e.left)
+}
+
+// EitherFromT2 converts a tuple of Optionals into an Either. It fails unless
+// exactly one of the Optionals is set.
+func EitherFromT2[L, R any](t tuple.T2[Optional[L], Optional[R]]) Optional[Either[L, R]] {
+	switch {
+	case t.A.set && !t.B.set:
+		return Success(Left[L, R](t.A.val))
+	case t.B.set && !t.A.set:
+		return Success(Right[L](t.B.val))
+	default:
+		return Failure[Either[L, R]]()
+	}
+}
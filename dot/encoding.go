@@ -0,0 +1,347 @@
+package dot
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// IsZero checks if the Optional's value is not set. This allows struct fields
+// tagged with `json:",omitzero"` to be omitted if they are not set.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON encodes the value of the Optional or null if it isn't set.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON decodes a value into the Optional. null results in an
+// Optional without a value.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Failure[T]()
+		return nil
+	}
+
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+
+	*o = Success(t)
+	return nil
+}
+
+// MarshalText encodes the value of the Optional as text or returns an empty
+// text if it isn't set. Values implementing encoding.TextMarshaler use it,
+// other values are formatted with fmt.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.set {
+		return []byte{}, nil
+	}
+
+	switch v := any(o.val).(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return fmt.Append(nil, v), nil
+	}
+}
+
+// UnmarshalText decodes a text into the Optional. An empty text results in an
+// Optional without a value. Values implementing encoding.TextUnmarshaler use
+// it, strings and byte slices take the text as is and other values are parsed
+// with fmt.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Failure[T]()
+		return nil
+	}
+
+	var t T
+	if u, ok := any(&t).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if err := convertAssign(reflect.ValueOf(&t).Elem(), text); err != nil {
+		return err
+	}
+
+	*o = Success(t)
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL results in an Optional without a value.
+// Values implementing sql.Scanner use it, other values are converted between
+// compatible types.
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = Failure[T]()
+		return nil
+	}
+
+	var t T
+	if s, ok := any(&t).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+	} else if err := convertAssign(reflect.ValueOf(&t).Elem(), src); err != nil {
+		return err
+	}
+
+	*o = Success(t)
+	return nil
+}
+
+// Valuer returns a driver.Valuer for the Optional that yields NULL if the
+// value isn't set. Optional can't implement driver.Valuer itself because its
+// Value method returns the value.
+func (o Optional[T]) Valuer() driver.Valuer {
+	return optionalValuer[T]{o}
+}
+
+type optionalValuer[T any] struct {
+	o Optional[T]
+}
+
+func (v optionalValuer[T]) Value() (driver.Value, error) {
+	if !v.o.set {
+		return nil, nil
+	}
+
+	if valuer, ok := any(v.o.val).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(v.o.val)
+}
+
+func convertAssign(dst reflect.Value, src any) error {
+	sv := reflect.ValueOf(src)
+
+	switch s := src.(type) {
+	case []byte:
+		switch {
+		case dst.Kind() == reflect.String:
+			dst.SetString(string(s))
+			return nil
+		case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+			dst.SetBytes(bytes.Clone(s))
+			return nil
+		case isNumber(dst.Kind()) || dst.Kind() == reflect.Bool:
+			return parseText(dst, string(s))
+		}
+
+	case string:
+		switch {
+		case dst.Kind() == reflect.String:
+			dst.SetString(s)
+			return nil
+		case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+			dst.SetBytes([]byte(s))
+			return nil
+		case isNumber(dst.Kind()) || dst.Kind() == reflect.Bool:
+			return parseText(dst, s)
+		}
+	}
+
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+
+	if dst.Kind() == reflect.String && (isNumber(sv.Kind()) || sv.Kind() == reflect.Bool) {
+		dst.SetString(fmt.Sprint(src))
+		return nil
+	}
+
+	if isNumber(sv.Kind()) && isNumber(dst.Kind()) {
+		return convertNumber(dst, sv)
+	}
+
+	if sv.Kind() == dst.Kind() && sv.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(sv.Convert(dst.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %T into %s", src, dst.Type())
+}
+
+// parseText parses s into the number or bool dst. Unlike fmt.Sscan it rejects
+// trailing input and values that don't fit into dst.
+func parseText(dst reflect.Value, s string) error {
+	switch {
+	case dst.CanInt():
+		n, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(n)
+
+	case dst.CanUint():
+		n, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(n)
+
+	case dst.CanFloat():
+		f, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+
+	default:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(b)
+	}
+
+	return nil
+}
+
+// convertNumber assigns the number sv to the number dst. It fails if the value
+// doesn't fit into dst, is negative and dst is unsigned or has a fractional
+// part and dst is an integer.
+func convertNumber(dst, sv reflect.Value) error {
+	switch {
+	case dst.CanInt():
+		n, ok := toInt(sv)
+		if !ok || dst.OverflowInt(n) {
+			break
+		}
+		dst.SetInt(n)
+		return nil
+
+	case dst.CanUint():
+		n, ok := toUint(sv)
+		if !ok || dst.OverflowUint(n) {
+			break
+		}
+		dst.SetUint(n)
+		return nil
+
+	default:
+		f := toFloat(sv)
+		if dst.OverflowFloat(f) {
+			break
+		}
+		dst.SetFloat(f)
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %v into %s without loss", sv, dst.Type())
+}
+
+func toInt(v reflect.Value) (int64, bool) {
+	switch {
+	case v.CanInt():
+		return v.Int(), true
+	case v.CanUint():
+		n := v.Uint()
+		return int64(n), n <= math.MaxInt64
+	default:
+		f := v.Float()
+		return int64(f), f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64
+	}
+}
+
+func toUint(v reflect.Value) (uint64, bool) {
+	switch {
+	case v.CanInt():
+		n := v.Int()
+		return uint64(n), n >= 0
+	case v.CanUint():
+		return v.Uint(), true
+	default:
+		f := v.Float()
+		return uint64(f), f == math.Trunc(f) && f >= 0 && f < math.MaxUint64
+	}
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+type resultJSON struct {
+	Value json.RawMessage `json:"value,omitempty"`
+	Error *string         `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the result as {"value":…} if it was successful or as
+// {"error":"…"} with the error message otherwise.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		msg := r.err.Error()
+		return json.Marshal(resultJSON{Error: &msg})
+	}
+
+	val, err := json.Marshal(r.val)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resultJSON{Value: val})
+}
+
+// UnmarshalJSON decodes a result encoded by MarshalJSON. The error of the
+// result only retains the error message.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var rj resultJSON
+	if err := json.Unmarshal(data, &rj); err != nil {
+		return err
+	}
+
+	if rj.Error != nil {
+		*r = Err[T](errors.New(*rj.Error))
+		return nil
+	}
+
+	var t T
+	if len(rj.Value) > 0 {
+		if err := json.Unmarshal(rj.Value, &t); err != nil {
+			return err
+		}
+	}
+
+	*r = OK(t)
+	return nil
+}
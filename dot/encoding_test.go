@@ -0,0 +1,260 @@
+package dot
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type name string
+
+type payload struct {
+	A Optional[int]    `json:"a"`
+	B Optional[string] `json:"b"`
+}
+
+func TestOptionalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   payload
+		json string
+	}{
+		{"set", payload{A: Success(1), B: Success("x")}, `{"a":1,"b":"x"}`},
+		{"unset", payload{}, `{"a":null,"b":null}`},
+		{"mixed", payload{A: Success(0)}, `{"a":0,"b":null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.json {
+				t.Fatalf("Marshal() = %s, want %s", data, tt.json)
+			}
+
+			var out payload
+			if err := json.Unmarshal(data, &out); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !equalOptional(out.A, tt.in.A) || !equalOptional(out.B, tt.in.B) {
+				t.Fatalf("Unmarshal() = %+v, want %+v", out, tt.in)
+			}
+		})
+	}
+
+	var out payload
+	if err := json.Unmarshal([]byte(`{"b":"y"}`), &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.A.HasValue() || out.B.Value() != "y" {
+		t.Fatalf("Unmarshal() with omitted field = %+v", out)
+	}
+}
+
+func testTextRoundTrip[T comparable](t *testing.T, o Optional[T], text string) {
+	t.Helper()
+
+	data, err := o.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if string(data) != text {
+		t.Fatalf("MarshalText() = %q, want %q", data, text)
+	}
+
+	var out Optional[T]
+	if err := out.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if !equalOptional(out, o) {
+		t.Fatalf("UnmarshalText() = %v, want %v", out, o)
+	}
+}
+
+func TestOptionalText(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("int", func(t *testing.T) { testTextRoundTrip(t, Success(42), "42") })
+	t.Run("float", func(t *testing.T) { testTextRoundTrip(t, Success(1.5), "1.5") })
+	t.Run("bool", func(t *testing.T) { testTextRoundTrip(t, Success(true), "true") })
+	t.Run("string", func(t *testing.T) { testTextRoundTrip(t, Success("hello world"), "hello world") })
+	t.Run("named string", func(t *testing.T) { testTextRoundTrip(t, Success(name("hello world")), "hello world") })
+	t.Run("text marshaler", func(t *testing.T) { testTextRoundTrip(t, Success(ts), "2024-01-02T03:04:05Z") })
+	t.Run("unset", func(t *testing.T) { testTextRoundTrip(t, Failure[int](), "") })
+
+	t.Run("bytes", func(t *testing.T) {
+		text := []byte("abc")
+
+		var o Optional[[]byte]
+		if err := o.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText() error = %v", err)
+		}
+
+		text[0] = 'x'
+		if string(o.Value()) != "abc" {
+			t.Fatalf("UnmarshalText() = %q, want a copy of the text", o.Value())
+		}
+	})
+}
+
+func testSQLRoundTrip[T comparable](t *testing.T, o Optional[T]) {
+	t.Helper()
+
+	v, err := o.Valuer().Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+
+	var out Optional[T]
+	if err := out.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v) error = %v", v, err)
+	}
+	if !equalOptional(out, o) {
+		t.Fatalf("Scan(%#v) = %v, want %v", v, out, o)
+	}
+}
+
+func TestOptionalSQL(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testSQLRoundTrip(t, Success(42)) })
+	t.Run("int64", func(t *testing.T) { testSQLRoundTrip(t, Success(int64(42))) })
+	t.Run("float", func(t *testing.T) { testSQLRoundTrip(t, Success(1.5)) })
+	t.Run("string", func(t *testing.T) { testSQLRoundTrip(t, Success("hello world")) })
+	t.Run("named string", func(t *testing.T) { testSQLRoundTrip(t, Success(name("hello world"))) })
+	t.Run("time", func(t *testing.T) { testSQLRoundTrip(t, Success(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))) })
+	t.Run("unset", func(t *testing.T) { testSQLRoundTrip(t, Failure[string]()) })
+
+	tests := []struct {
+		name string
+		src  any
+		want Optional[string]
+	}{
+		{"bytes", []byte("abc"), Success("abc")},
+		{"int64", int64(42), Success("42")},
+		{"null", nil, Failure[string]()},
+	}
+
+	for _, tt := range tests {
+		t.Run("scan "+tt.name, func(t *testing.T) {
+			var o Optional[string]
+			if err := o.Scan(tt.src); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if !equalOptional(o, tt.want) {
+				t.Fatalf("Scan() = %v, want %v", o, tt.want)
+			}
+		})
+	}
+
+	t.Run("scan bytes copy", func(t *testing.T) {
+		src := []byte("abc")
+
+		var o Optional[[]byte]
+		if err := o.Scan(src); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+
+		src[0] = 'x'
+		if string(o.Value()) != "abc" {
+			t.Fatalf("Scan() = %q, want a copy of the source", o.Value())
+		}
+	})
+
+	t.Run("scan number from bytes", func(t *testing.T) {
+		var o Optional[float64]
+		if err := o.Scan([]byte("2.5")); err != nil || o.Value() != 2.5 {
+			t.Fatalf("Scan() = %v, %v; want 2.5", o, err)
+		}
+	})
+
+	t.Run("scan unsupported", func(t *testing.T) {
+		var o Optional[time.Time]
+		if err := o.Scan(int64(1)); err == nil {
+			t.Fatalf("Scan() error = nil, want error")
+		}
+	})
+}
+
+func TestResultJSON(t *testing.T) {
+	data, err := json.Marshal(OK(1))
+	if err != nil || string(data) != `{"value":1}` {
+		t.Fatalf("Marshal(OK) = %s, %v; want {\"value\":1}", data, err)
+	}
+
+	var r Result[int]
+	if err := json.Unmarshal(data, &r); err != nil || !r.Success() || r.Value() != 1 {
+		t.Fatalf("Unmarshal(%s) = %v, %v", data, r, err)
+	}
+
+	data, err = json.Marshal(Err[int](errors.New("bad")))
+	if err != nil || string(data) != `{"error":"bad"}` {
+		t.Fatalf("Marshal(Err) = %s, %v; want {\"error\":\"bad\"}", data, err)
+	}
+
+	if err := json.Unmarshal(data, &r); err != nil || r.Success() || r.Error().Error() != "bad" {
+		t.Fatalf("Unmarshal(%s) = %v, %v", data, r, err)
+	}
+}
+
+func scanAs[T any](src any) func() (any, error) {
+	return func() (any, error) {
+		var o Optional[T]
+		err := o.Scan(src)
+		return o, err
+	}
+}
+
+func unmarshalAs[T any](text string) func() (any, error) {
+	return func() (any, error) {
+		var o Optional[T]
+		err := o.UnmarshalText([]byte(text))
+		return o, err
+	}
+}
+
+func TestOptionalNumberConversion(t *testing.T) {
+	tests := []struct {
+		name string
+		conv func() (any, error)
+		want any // nil if the conversion must fail
+	}{
+		{"int64 into int8", scanAs[int8](int64(100)), Success(int8(100))},
+		{"int64 overflows int8", scanAs[int8](int64(300)), nil},
+		{"negative into uint", scanAs[uint](int64(-1)), nil},
+		{"uint64 overflows int64", scanAs[int64](uint64(1 << 63)), nil},
+		{"whole float into int", scanAs[int](2.0), Success(2)},
+		{"fraction into int", scanAs[int](1.9), nil},
+		{"negative float into uint", scanAs[uint](-1.0), nil},
+		{"float64 overflows float32", scanAs[float32](1e300), nil},
+		{"int into float", scanAs[float64](int64(3)), Success(3.0)},
+		{"text int", unmarshalAs[int]("12"), Success(12)},
+		{"text trailing input", unmarshalAs[int]("12abc"), nil},
+		{"text overflows int8", unmarshalAs[int8]("300"), nil},
+		{"text negative into uint", unmarshalAs[uint]("-1"), nil},
+		{"text float32", unmarshalAs[float32]("1.5"), Success(float32(1.5))},
+		{"text bool", unmarshalAs[bool]("false"), Success(false)},
+		{"text invalid bool", unmarshalAs[bool]("maybe"), nil},
+		{"bytes overflow int16", scanAs[int16]([]byte("70000")), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conv()
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("conversion = %v, want error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("conversion error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("conversion = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package dot
+
+import (
+	"sync"
+	"time"
+)
+
+// lazy holds the cached value shared by Lazy and LazyResult.
+type lazy[T any] struct {
+	compute sync.Mutex
+
+	m       sync.RWMutex
+	ttl     time.Duration
+	val     T
+	set     bool
+	expires time.Time
+}
+
+func (l *lazy[T]) cached() Optional[T] {
+	l.m.RLock()
+	defer l.m.RUnlock()
+
+	if !l.set || (l.ttl > 0 && !time.Now().Before(l.expires)) {
+		return Failure[T]()
+	}
+
+	return Success(l.val)
+}
+
+// get returns the cached value or calls f to compute it. The value is only
+// cached if f returns true.
+func (l *lazy[T]) get(f func() (T, bool)) T {
+	if o := l.cached(); o.set {
+		return o.val
+	}
+
+	l.compute.Lock()
+	defer l.compute.Unlock()
+
+	// another goroutine may have computed the value while we were waiting
+	if o := l.cached(); o.set {
+		return o.val
+	}
+
+	t, cache := f()
+	if cache {
+		l.m.Lock()
+		l.val, l.set, l.expires = t, true, time.Now().Add(l.ttl)
+		l.m.Unlock()
+	}
+
+	return t
+}
+
+func (l *lazy[T]) reset() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.val, l.set = Default[T](), false
+}
+
+// Lazy computes a value on first access and caches it. It is safe for
+// concurrent use, the value is only computed once even if many goroutines
+// access it at the same time.
+type Lazy[T any] struct {
+	l lazy[T]
+	f func() T
+}
+
+// NewLazy creates a new Lazy computing its value with f.
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// NewLazyTTL creates a new Lazy computing its value with f. The value is
+// computed again on the first access after ttl passed.
+func NewLazyTTL[T any](f func() T, ttl time.Duration) *Lazy[T] {
+	return &Lazy[T]{l: lazy[T]{ttl: ttl}, f: f}
+}
+
+// Get returns the value, computing it if necessary.
+func (l *Lazy[T]) Get() T {
+	return l.l.get(func() (T, bool) {
+		return l.f(), true
+	})
+}
+
+// Peek returns the value if it has been computed, without computing it.
+func (l *Lazy[T]) Peek() Optional[T] {
+	return l.l.cached()
+}
+
+// Reset discards the value, so it is computed again on the next access.
+func (l *Lazy[T]) Reset() {
+	l.l.reset()
+}
+
+// LazyResult is like Lazy for functions that may fail. Errors are not cached,
+// the next access calls the function again.
+type LazyResult[T any] struct {
+	l lazy[T]
+	f func() (T, error)
+}
+
+// NewLazyResult creates a new LazyResult computing its value with f.
+func NewLazyResult[T any](f func() (T, error)) *LazyResult[T] {
+	return &LazyResult[T]{f: f}
+}
+
+// NewLazyResultTTL creates a new LazyResult computing its value with f. The
+// value is computed again on the first access after ttl passed.
+func NewLazyResultTTL[T any](f func() (T, error), ttl time.Duration) *LazyResult[T] {
+	return &LazyResult[T]{l: lazy[T]{ttl: ttl}, f: f}
+}
+
+// Get returns the value, computing it if necessary.
+func (l *LazyResult[T]) Get() Result[T] {
+	var err error
+	t := l.l.get(func() (T, bool) {
+		var t T
+		t, err = l.f()
+		return t, err == nil
+	})
+
+	return Wrap(t, err)
+}
+
+// Peek returns the value if it has been computed successfully, without
+// computing it.
+func (l *LazyResult[T]) Peek() Optional[T] {
+	return l.l.cached()
+}
+
+// Reset discards the value, so it is computed again on the next access.
+func (l *LazyResult[T]) Reset() {
+	l.l.reset()
+}
+
+// Memoize returns a function that calls f once per argument and returns the
+// cached result for further calls. It is safe for concurrent use.
+func Memoize[K comparable, V any](f func(K) V) func(K) V {
+	var (
+		m     sync.Mutex
+		cache = make(map[K]*Lazy[V])
+	)
+
+	return func(k K) V {
+		m.Lock()
+		l, ok := cache[k]
+		if !ok {
+			l = NewLazy(func() V { return f(k) })
+			cache[k] = l
+		}
+		m.Unlock()
+
+		return l.Get()
+	}
+}
@@ -0,0 +1,92 @@
+package dot
+
+// OrElse returns the value of the Optional or t if it isn't set.
+func (o Optional[T]) OrElse(t T) T {
+	if o.set {
+		return o.val
+	}
+
+	return t
+}
+
+// OrElseGet returns the value of the Optional or the result of f if it isn't
+// set. f is only called if the value is missing.
+func (o Optional[T]) OrElseGet(f func() T) T {
+	if o.set {
+		return o.val
+	}
+
+	return f()
+}
+
+// Or returns the first Optional with a value set, starting with o.
+func (o Optional[T]) Or(others ...Optional[T]) Optional[T] {
+	if o.set {
+		return o
+	}
+
+	for _, other := range others {
+		if other.set {
+			return other
+		}
+	}
+
+	return o
+}
+
+// Filter returns the Optional if its value is set and f returns true for it.
+func (o Optional[T]) Filter(f func(T) bool) Optional[T] {
+	if o.set && f(o.val) {
+		return o
+	}
+
+	return Failure[T]()
+}
+
+// ToResult converts the Optional into a Result with the error set to err if
+// the value isn't set.
+func (o Optional[T]) ToResult(err error) Result[T] {
+	if o.set {
+		return OK(o.val)
+	}
+
+	return Err[T](err)
+}
+
+// Ptr returns a pointer to a copy of the value or nil if it isn't set.
+func (o Optional[T]) Ptr() *T {
+	if o.set {
+		val := o.val
+		return &val
+	}
+
+	return nil
+}
+
+// FromPtr creates an Optional from a pointer. The value is set if p isn't nil.
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Failure[T]()
+	}
+
+	return Success(*p)
+}
+
+// MapOptional applies f to the value of o if it is set.
+func MapOptional[T, S any](o Optional[T], f func(T) S) Optional[S] {
+	if o.set {
+		return Success(f(o.val))
+	}
+
+	return Failure[S]()
+}
+
+// FlatMapOptional applies f to the value of o if it is set and returns its
+// result.
+func FlatMapOptional[T, S any](o Optional[T], f func(T) Optional[S]) Optional[S] {
+	if o.set {
+		return f(o.val)
+	}
+
+	return Failure[S]()
+}
@@ -0,0 +1,213 @@
+package dot
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func equalOptional[T comparable](a, b Optional[T]) bool {
+	return a.HasValue() == b.HasValue() && a.Value() == b.Value()
+}
+
+func TestOptionalOrElse(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Optional[int]
+		want int
+	}{
+		{"set", Success(1), 1},
+		{"unset", Failure[int](), 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.OrElse(2); got != tt.want {
+				t.Errorf("OrElse() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalOrElseGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		o      Optional[int]
+		want   int
+		called bool
+	}{
+		{"set", Success(1), 1, false},
+		{"unset", Failure[int](), 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			got := tt.o.OrElseGet(func() int {
+				called = true
+				return 2
+			})
+
+			if got != tt.want {
+				t.Errorf("OrElseGet() = %d, want %d", got, tt.want)
+			}
+			if called != tt.called {
+				t.Errorf("f called = %t, want %t", called, tt.called)
+			}
+		})
+	}
+}
+
+func TestOptionalOr(t *testing.T) {
+	tests := []struct {
+		name   string
+		o      Optional[int]
+		others []Optional[int]
+		want   Optional[int]
+	}{
+		{"set", Success(1), []Optional[int]{Success(2)}, Success(1)},
+		{"unset no others", Failure[int](), nil, Failure[int]()},
+		{"first other", Failure[int](), []Optional[int]{Success(2), Success(3)}, Success(2)},
+		{"several absent", Failure[int](), []Optional[int]{Failure[int](), Failure[int](), Success(4), Success(5)}, Success(4)},
+		{"all absent", Failure[int](), []Optional[int]{Failure[int](), Failure[int]()}, Failure[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Or(tt.others...); !equalOptional(got, tt.want) {
+				t.Errorf("Or() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalFilter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name string
+		o    Optional[int]
+		want Optional[int]
+	}{
+		{"set match", Success(2), Success(2)},
+		{"set no match", Success(1), Failure[int]()},
+		{"unset", Failure[int](), Failure[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Filter(even); !equalOptional(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalToResult(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	tests := []struct {
+		name string
+		o    Optional[int]
+		want Result[int]
+	}{
+		{"set", Success(1), OK(1)},
+		{"unset", Failure[int](), Err[int](errMissing)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.o.ToResult(errMissing)
+			if got.Value() != tt.want.Value() || got.Error() != tt.want.Error() {
+				t.Errorf("ToResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalPtr(t *testing.T) {
+	if p := Failure[int]().Ptr(); p != nil {
+		t.Errorf("Ptr() of unset = %v, want nil", p)
+	}
+
+	o := Success(1)
+	p := o.Ptr()
+	if p == nil || *p != 1 {
+		t.Fatalf("Ptr() = %v, want pointer to 1", p)
+	}
+
+	*p = 2
+	if o.Value() != 1 {
+		t.Errorf("modifying the pointer changed the Optional to %d", o.Value())
+	}
+	if p2 := o.Ptr(); p2 == p {
+		t.Errorf("Ptr() returned the same pointer twice")
+	}
+}
+
+func TestFromPtr(t *testing.T) {
+	i := 1
+
+	tests := []struct {
+		name string
+		p    *int
+		want Optional[int]
+	}{
+		{"set", &i, Success(1)},
+		{"unset", nil, Failure[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromPtr(tt.p); !equalOptional(got, tt.want) {
+				t.Errorf("FromPtr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapOptional(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Optional[int]
+		want Optional[string]
+	}{
+		{"set", Success(1), Success("1")},
+		{"unset", Failure[int](), Failure[string]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapOptional(tt.o, strconv.Itoa); !equalOptional(got, tt.want) {
+				t.Errorf("MapOptional() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMapOptional(t *testing.T) {
+	positive := func(i int) Optional[string] {
+		if i > 0 {
+			return Success(strconv.Itoa(i))
+		}
+		return Failure[string]()
+	}
+
+	tests := []struct {
+		name string
+		o    Optional[int]
+		want Optional[string]
+	}{
+		{"set present", Success(1), Success("1")},
+		{"set absent", Success(-1), Failure[string]()},
+		{"unset", Failure[int](), Failure[string]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMapOptional(tt.o, positive); !equalOptional(got, tt.want) {
+				t.Errorf("FlatMapOptional() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
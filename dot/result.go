@@ -0,0 +1,93 @@
+package dot
+
+import "errors"
+
+// UnwrapOr returns the value of the result or t if it holds an error.
+func (r Result[T]) UnwrapOr(t T) T {
+	if r.err != nil {
+		return t
+	}
+
+	return r.val
+}
+
+// MapErr applies f to the error of the result if it holds one.
+func (r Result[T]) MapErr(f func(error) error) Result[T] {
+	if r.err != nil {
+		return Err[T](f(r.err))
+	}
+
+	return r
+}
+
+// OrElse returns the result if it was successful or the result of f for its
+// error otherwise.
+func (r Result[T]) OrElse(f func(error) Result[T]) Result[T] {
+	if r.err != nil {
+		return f(r.err)
+	}
+
+	return r
+}
+
+// Is reports whether the error of the result matches target, see errors.Is.
+func (r Result[T]) Is(target error) bool {
+	return errors.Is(r.err, target)
+}
+
+// As finds the first error in the error chain of the result that matches
+// target, see errors.As.
+func (r Result[T]) As(target any) bool {
+	if r.err == nil {
+		return false
+	}
+
+	return errors.As(r.err, target)
+}
+
+// MapResult applies f to the value of r if it was successful.
+func MapResult[T, S any](r Result[T], f func(T) S) Result[S] {
+	if r.err != nil {
+		return Err[S](r.err)
+	}
+
+	return OK(f(r.val))
+}
+
+// AndThen calls f with the value of r if it was successful and returns its
+// result.
+func AndThen[T, S any](r Result[T], f func(T) Result[S]) Result[S] {
+	if r.err != nil {
+		return Err[S](r.err)
+	}
+
+	return f(r.val)
+}
+
+// CollectResults turns a list of results into a result with the list of
+// values. It holds the first error if any of the results holds one.
+func CollectResults[T any](rs []Result[T]) Result[[]T] {
+	vals := make([]T, len(rs))
+	for i, r := range rs {
+		if r.err != nil {
+			return Err[[]T](r.err)
+		}
+		vals[i] = r.val
+	}
+
+	return OK(vals)
+}
+
+// PartitionResults splits a list of results into the values of the successful
+// ones and the errors of the others.
+func PartitionResults[T any](rs []Result[T]) (vals []T, errs []error) {
+	for _, r := range rs {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		} else {
+			vals = append(vals, r.val)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,50 @@
+package dot
+
+import "fmt"
+
+// UnwrapError is the error of a Result returned by Try and TryErr if a call to
+// Result.Unwrap failed. It records where Unwrap was called.
+type UnwrapError struct {
+	Err  error
+	File string
+	Line int
+}
+
+// Error returns the location of the failed Unwrap call and the error message.
+func (e *UnwrapError) Error() string {
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+// Unwrap returns the error of the Result Unwrap was called on.
+func (e *UnwrapError) Unwrap() error {
+	return e.Err
+}
+
+// Try calls f and returns its value. If a call to Result.Unwrap inside of f
+// fails, the error is returned as an *UnwrapError instead. Other panics are
+// not recovered. Unlike ReturnError, Try doesn't need a named return value and
+// can be used inside of goroutines and concurrent tasks.
+func Try[T any](f func() T) (r Result[T]) {
+	defer catchUnwrap(&r)
+	return OK(f())
+}
+
+// TryErr is like Try, but f also returns an error.
+func TryErr[T any](f func() (T, error)) (r Result[T]) {
+	defer catchUnwrap(&r)
+	return Wrap(f())
+}
+
+func catchUnwrap[T any](into *Result[T]) {
+	e := recover()
+	if e == nil {
+		return
+	}
+
+	if ne, ok := e.(noxPanic); ok {
+		*into = Err[T](&UnwrapError{Err: ne.err, File: ne.file, Line: ne.line})
+		return
+	}
+
+	panic(e)
+}
@@ -0,0 +1,138 @@
+package enumerate
+
+import (
+	"golang.org/x/exp/constraints"
+
+	. "github.com/noxer/nox/dot"
+)
+
+// Accumulator collects the elements of an enumerable, see Aggregate.
+type Accumulator[T any] interface {
+	Add(t T)
+}
+
+// Aggregator is an Accumulator that computes a result of type R from the
+// elements added to it.
+type Aggregator[T, R any] interface {
+	Accumulator[T]
+	Result() R
+}
+
+// Aggregate reads all values from an enumerable and adds each of them to all
+// accumulators, so several aggregates can be computed in a single pass. It
+// returns the error that ended e.
+func Aggregate[T any](e Enumerable[T], accs ...Accumulator[T]) error {
+	for e.Next() {
+		val := e.Value()
+		for _, acc := range accs {
+			acc.Add(val)
+		}
+	}
+	return Error(e)
+}
+
+type foldAgg[T, S any] struct {
+	acc S
+	f   func(S, T) S
+}
+
+func (a *foldAgg[T, S]) Add(t T) {
+	a.acc = a.f(a.acc, t)
+}
+
+func (a *foldAgg[T, S]) Result() S {
+	return a.acc
+}
+
+// FoldOf combines the elements with f, starting with init as the accumulator.
+func FoldOf[T, S any](init S, f func(S, T) S) Aggregator[T, S] {
+	return &foldAgg[T, S]{acc: init, f: f}
+}
+
+// SumOf sums up the elements.
+func SumOf[T Number]() Aggregator[T, T] {
+	return FoldOf(Default[T](), func(sum, t T) T { return sum + t })
+}
+
+// CountOf counts the elements.
+func CountOf[T any]() Aggregator[T, int] {
+	return FoldOf(0, func(n int, _ T) int { return n + 1 })
+}
+
+type argAgg[T any, K constraints.Ordered] struct {
+	key  func(T) K
+	less bool
+	best T
+	k    K
+	set  bool
+}
+
+func (a *argAgg[T, K]) Add(t T) {
+	k := a.key(t)
+	if !a.set || (a.less && k < a.k) || (!a.less && k > a.k) {
+		a.best, a.k, a.set = t, k, true
+	}
+}
+
+func (a *argAgg[T, K]) Result() Optional[T] {
+	if !a.set {
+		return Failure[T]()
+	}
+	return Success(a.best)
+}
+
+// MinOf finds the smallest element.
+func MinOf[T constraints.Ordered]() Aggregator[T, Optional[T]] {
+	return ArgMin(func(t T) T { return t })
+}
+
+// MaxOf finds the biggest element.
+func MaxOf[T constraints.Ordered]() Aggregator[T, Optional[T]] {
+	return ArgMax(func(t T) T { return t })
+}
+
+// ArgMin finds the first element with the smallest key.
+func ArgMin[T any, K constraints.Ordered](key func(T) K) Aggregator[T, Optional[T]] {
+	return &argAgg[T, K]{key: key, less: true}
+}
+
+// ArgMax finds the first element with the biggest key.
+func ArgMax[T any, K constraints.Ordered](key func(T) K) Aggregator[T, Optional[T]] {
+	return &argAgg[T, K]{key: key}
+}
+
+// welford computes the mean and variance of the elements in a numerically
+// stable way.
+type welford[T constraints.Integer | constraints.Float] struct {
+	n        int
+	mean, m2 float64
+	variance bool
+}
+
+func (a *welford[T]) Add(t T) {
+	a.n++
+	delta := float64(t) - a.mean
+	a.mean += delta / float64(a.n)
+	a.m2 += delta * (float64(t) - a.mean)
+}
+
+func (a *welford[T]) Result() Optional[float64] {
+	switch {
+	case a.n == 0:
+		return Failure[float64]()
+	case a.variance:
+		return Success(a.m2 / float64(a.n))
+	default:
+		return Success(a.mean)
+	}
+}
+
+// MeanOf computes the arithmetic mean of the elements.
+func MeanOf[T constraints.Integer | constraints.Float]() Aggregator[T, Optional[float64]] {
+	return &welford[T]{}
+}
+
+// VarianceOf computes the population variance of the elements.
+func VarianceOf[T constraints.Integer | constraints.Float]() Aggregator[T, Optional[float64]] {
+	return &welford[T]{variance: true}
+}
@@ -0,0 +1,95 @@
+package enumerate
+
+import (
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/list"
+	"github.com/noxer/nox/set"
+)
+
+// ToSlice reads all values from an enumerable and returns them as a slice.
+func ToSlice[T any](e Enumerable[T]) []T {
+	var sl []T
+	for e.Next() {
+		sl = append(sl, e.Value())
+	}
+	return sl
+}
+
+// ToList reads all values from an enumerable and returns them as a linked
+// list.
+func ToList[T any](e Enumerable[T]) *list.Linked[T] {
+	return list.New(ToSlice(e)...)
+}
+
+// ToSet reads all values from an enumerable and returns a set of unique
+// values. It's the same as Unique.
+func ToSet[T comparable](e Enumerable[T]) set.Set[T] {
+	return Unique(e)
+}
+
+// ToMap reads all values from an enumerable and returns a map with the keys
+// and values returned by key and val for them. If two values have the same
+// key, conflict is called with the key, the existing and the new value and
+// its result is stored. A nil conflict keeps the last value.
+func ToMap[T any, K comparable, V any](e Enumerable[T], key func(T) K, val func(T) V, conflict func(K, V, V) V) map[K]V {
+	m := make(map[K]V)
+	for e.Next() {
+		t := e.Value()
+		k, v := key(t), val(t)
+
+		if old, ok := m[k]; ok && conflict != nil {
+			v = conflict(k, old, v)
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// GroupBy reads all values from an enumerable and groups them by the key
+// returned by key. The values of a group keep their order.
+func GroupBy[T any, K comparable](e Enumerable[T], key func(T) K) map[K][]T {
+	m := make(map[K][]T)
+	for e.Next() {
+		t := e.Value()
+		k := key(t)
+		m[k] = append(m[k], t)
+	}
+	return m
+}
+
+// Partition reads all values from an enumerable and splits them into the ones
+// f returns true for and the others.
+func Partition[T any](e Enumerable[T], f func(T) bool) (yes, no []T) {
+	for e.Next() {
+		if t := e.Value(); f(t) {
+			yes = append(yes, t)
+		} else {
+			no = append(no, t)
+		}
+	}
+	return
+}
+
+// Reduce reads all values from an enumerable and combines them with f, using
+// the first value as the initial accumulator.
+func Reduce[T any](e Enumerable[T], f func(T, T) T) Optional[T] {
+	if !e.Next() {
+		return Failure[T]()
+	}
+	acc := e.Value()
+
+	for e.Next() {
+		acc = f(acc, e.Value())
+	}
+	return Success(acc)
+}
+
+// Fold reads all values from an enumerable and combines them with f, starting
+// with init as the accumulator.
+func Fold[T, S any](e Enumerable[T], init S, f func(S, T) S) S {
+	acc := init
+	for e.Next() {
+		acc = f(acc, e.Value())
+	}
+	return acc
+}
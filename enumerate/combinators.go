@@ -0,0 +1,258 @@
+package enumerate
+
+import (
+	"errors"
+
+	. "github.com/noxer/nox/dot"
+)
+
+type enumTake[T any] struct {
+	e Enumerable[T]
+	n int
+}
+
+func (e *enumTake[T]) Next() bool {
+	if e.n <= 0 {
+		return false
+	}
+
+	e.n--
+	return e.e.Next()
+}
+
+func (e *enumTake[T]) Value() T {
+	return e.e.Value()
+}
+
+func (e *enumTake[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumTake[T]) Close() error {
+	return Close(e.e)
+}
+
+// Take lets only the first n elements of e pass.
+func Take[T any](e Enumerable[T], n int) Enumerable[T] {
+	return &enumTake[T]{e, n}
+}
+
+type enumSkip[T any] struct {
+	e Enumerable[T]
+	n int
+}
+
+func (e *enumSkip[T]) Next() bool {
+	for ; e.n > 0; e.n-- {
+		if !e.e.Next() {
+			e.n = 0
+			return false
+		}
+	}
+
+	return e.e.Next()
+}
+
+func (e *enumSkip[T]) Value() T {
+	return e.e.Value()
+}
+
+func (e *enumSkip[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumSkip[T]) Close() error {
+	return Close(e.e)
+}
+
+// Skip drops the first n elements of e.
+func Skip[T any](e Enumerable[T], n int) Enumerable[T] {
+	return &enumSkip[T]{e, n}
+}
+
+type enumTakeWhile[T any] struct {
+	e    Enumerable[T]
+	f    func(T) bool
+	done bool
+}
+
+func (e *enumTakeWhile[T]) Next() bool {
+	if e.done {
+		return false
+	}
+
+	if e.e.Next() && e.f(e.e.Value()) {
+		return true
+	}
+
+	e.done = true
+	return false
+}
+
+func (e *enumTakeWhile[T]) Value() T {
+	return e.e.Value()
+}
+
+func (e *enumTakeWhile[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumTakeWhile[T]) Close() error {
+	return Close(e.e)
+}
+
+// TakeWhile lets the elements of e pass until f returns false for one of them.
+func TakeWhile[T any](e Enumerable[T], f func(T) bool) Enumerable[T] {
+	return &enumTakeWhile[T]{e: e, f: f}
+}
+
+type enumSkipWhile[T any] struct {
+	e       Enumerable[T]
+	f       func(T) bool
+	skipped bool
+}
+
+func (e *enumSkipWhile[T]) Next() bool {
+	if e.skipped {
+		return e.e.Next()
+	}
+
+	e.skipped = true
+	for e.e.Next() {
+		if !e.f(e.e.Value()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *enumSkipWhile[T]) Value() T {
+	return e.e.Value()
+}
+
+func (e *enumSkipWhile[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumSkipWhile[T]) Close() error {
+	return Close(e.e)
+}
+
+// SkipWhile drops the elements of e until f returns false for one of them.
+func SkipWhile[T any](e Enumerable[T], f func(T) bool) Enumerable[T] {
+	return &enumSkipWhile[T]{e: e, f: f}
+}
+
+type enumChain[T any] struct {
+	es  []Enumerable[T]
+	i   int
+	err error
+}
+
+func (e *enumChain[T]) Next() bool {
+	for e.err == nil && e.i < len(e.es) {
+		if e.es[e.i].Next() {
+			return true
+		}
+
+		e.err = Error(e.es[e.i])
+		e.i++
+	}
+
+	return false
+}
+
+func (e *enumChain[T]) Value() T {
+	if e.i >= len(e.es) {
+		return Default[T]()
+	}
+
+	return e.es[e.i].Value()
+}
+
+func (e *enumChain[T]) Err() error {
+	return e.err
+}
+
+func (e *enumChain[T]) Close() error {
+	errs := make([]error, len(e.es))
+	for i, es := range e.es {
+		errs[i] = Close(es)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Chain returns the elements of all enumerables, one after another. It stops
+// at the first enumerable that ends with an error.
+func Chain[T any](es ...Enumerable[T]) Enumerable[T] {
+	return &enumChain[T]{es: es}
+}
+
+type enumFlatMap[T, S any] struct {
+	e   Enumerable[T]
+	f   func(T) Enumerable[S]
+	cur Enumerable[S]
+	err error
+}
+
+func (e *enumFlatMap[T, S]) Next() bool {
+	for e.err == nil {
+		if e.cur != nil {
+			if e.cur.Next() {
+				return true
+			}
+
+			e.err = errors.Join(Error(e.cur), Close(e.cur))
+			e.cur = nil
+			continue
+		}
+
+		if !e.e.Next() {
+			return false
+		}
+		e.cur = e.f(e.e.Value())
+	}
+
+	return false
+}
+
+func (e *enumFlatMap[T, S]) Value() S {
+	if e.cur == nil {
+		return Default[S]()
+	}
+
+	return e.cur.Value()
+}
+
+func (e *enumFlatMap[T, S]) Err() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	return Error(e.e)
+}
+
+func (e *enumFlatMap[T, S]) Close() error {
+	var err error
+	if e.cur != nil {
+		err = Close(e.cur)
+		e.cur = nil
+	}
+
+	return errors.Join(err, Close(e.e))
+}
+
+// FlatMap applies a function f to every element of the enumerable e and
+// returns the elements of the resulting enumerables, one after another. The
+// resulting enumerables are closed once they have been read. FlatMap stops at
+// the first of them that ends with an error.
+func FlatMap[T, S any](e Enumerable[T], f func(T) Enumerable[S]) Enumerable[S] {
+	return &enumFlatMap[T, S]{e: e, f: f}
+}
+
+// Flatten returns the elements of the enumerables in e, one after another.
+func Flatten[T any](e Enumerable[Enumerable[T]]) Enumerable[T] {
+	return FlatMap(e, func(e Enumerable[T]) Enumerable[T] { return e })
+}
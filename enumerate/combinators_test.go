@@ -0,0 +1,157 @@
+package enumerate
+
+import (
+	"testing"
+
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/slice"
+)
+
+// countingEnum returns its values and counts the calls to Next.
+type countingEnum struct {
+	vals  []int
+	i     int
+	nexts int
+}
+
+func counting(vals ...int) *countingEnum {
+	return &countingEnum{vals: vals}
+}
+
+func (e *countingEnum) Next() bool {
+	e.nexts++
+	if e.i >= len(e.vals) {
+		return false
+	}
+
+	e.i++
+	return true
+}
+
+func (e *countingEnum) Value() int {
+	return e.vals[e.i-1]
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func checkEnum(t *testing.T, e Enumerable[int], want []int) {
+	t.Helper()
+
+	if got := ToSlice(e); !equalInts(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func checkNexts(t *testing.T, src *countingEnum, want int) {
+	t.Helper()
+
+	if src.nexts != want {
+		t.Fatalf("source Next called %d times, want %d", src.nexts, want)
+	}
+}
+
+func TestTake(t *testing.T) {
+	src := counting(1, 2, 3, 4, 5)
+	checkEnum(t, Take[int](src, 3), []int{1, 2, 3})
+	checkNexts(t, src, 3)
+
+	src = counting(1, 2, 3, 4, 5)
+	checkEnum(t, Take[int](src, 0), nil)
+	checkNexts(t, src, 0)
+
+	src = counting(1, 2)
+	checkEnum(t, Take[int](src, 5), []int{1, 2})
+	checkNexts(t, src, 3)
+}
+
+func TestSkip(t *testing.T) {
+	src := counting(1, 2, 3, 4, 5)
+	e := Skip[int](src, 2)
+	if !e.Next() || e.Value() != 3 {
+		t.Fatalf("first element = %d, want 3", e.Value())
+	}
+	checkNexts(t, src, 3)
+	checkEnum(t, e, []int{4, 5})
+
+	src = counting(1, 2)
+	checkEnum(t, Skip[int](src, 5), nil)
+	checkNexts(t, src, 3)
+}
+
+func TestTakeWhile(t *testing.T) {
+	src := counting(1, 2, 3, 4, 5)
+	e := TakeWhile[int](src, func(i int) bool { return i < 3 })
+	checkEnum(t, e, []int{1, 2})
+	checkNexts(t, src, 3)
+
+	// the enumerable stays ended without pulling further elements
+	if e.Next() {
+		t.Fatal("Next() = true after the end")
+	}
+	checkNexts(t, src, 3)
+}
+
+func TestSkipWhile(t *testing.T) {
+	src := counting(1, 2, 3, 4, 1)
+	e := SkipWhile[int](src, func(i int) bool { return i < 3 })
+	if !e.Next() || e.Value() != 3 {
+		t.Fatalf("first element = %d, want 3", e.Value())
+	}
+	checkNexts(t, src, 3)
+	checkEnum(t, e, []int{4, 1})
+}
+
+func TestChain(t *testing.T) {
+	a, b, c := counting(1, 2), counting(3, 4, 5), counting(6)
+	checkEnum(t, Take[int](Chain[int](a, b, c), 3), []int{1, 2, 3})
+	checkNexts(t, a, 3)
+	checkNexts(t, b, 1)
+	checkNexts(t, c, 0)
+
+	checkEnum(t, Chain[int](counting(1), counting(), counting(2, 3)), []int{1, 2, 3})
+}
+
+func TestFlatMap(t *testing.T) {
+	var inner []*countingEnum
+	src := counting(1, 2, 3)
+	e := FlatMap[int](src, func(n int) Enumerable[int] {
+		vals := make([]int, n)
+		for i := range vals {
+			vals[i] = n
+		}
+
+		c := counting(vals...)
+		inner = append(inner, c)
+		return c
+	})
+
+	checkEnum(t, Take(e, 2), []int{1, 2})
+	checkNexts(t, src, 2)
+	if len(inner) != 2 {
+		t.Fatalf("f called %d times, want 2", len(inner))
+	}
+	checkNexts(t, inner[1], 1)
+
+	checkEnum(t, FlatMap[int](counting(0, 1, 0, 2), func(n int) Enumerable[int] {
+		return Repeat(n, n)
+	}), []int{1, 2, 2})
+}
+
+func TestFlatten(t *testing.T) {
+	a, b := counting(1, 2), counting(3, 4)
+	src := slice.Enumerate([]Enumerable[int]{a, b})
+
+	checkEnum(t, Take(Flatten[int](src), 3), []int{1, 2, 3})
+	checkNexts(t, a, 3)
+	checkNexts(t, b, 1)
+}
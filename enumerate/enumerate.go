@@ -1,6 +1,7 @@
 package enumerate
 
 import (
+	"errors"
 	"sync"
 
 	"golang.org/x/exp/constraints"
@@ -23,6 +24,14 @@ func (e *enumMapper[T, S]) Value() S {
 	return e.f(e.e.Value())
 }
 
+func (e *enumMapper[T, S]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumMapper[T, S]) Close() error {
+	return Close(e.e)
+}
+
 // Map applies a function f to every element of the enumerable e.
 func Map[T, S any](e Enumerable[T], f func(T) S) Enumerable[S] {
 	return &enumMapper[T, S]{e, f}
@@ -47,6 +56,14 @@ func (e *enumFilter[T]) Value() T {
 	return e.e.Value()
 }
 
+func (e *enumFilter[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumFilter[T]) Close() error {
+	return Close(e.e)
+}
+
 // Filter lets only the elements pass that f returns true for.
 func Filter[T any](e Enumerable[T], f func(T) bool) Enumerable[T] {
 	return &enumFilter[T]{e, f}
@@ -65,6 +82,14 @@ func (e *enumZipper[T, S]) Value() tuple.T2[T, S] {
 	return tuple.T2[T, S]{A: e.a.Value(), B: e.b.Value()}
 }
 
+func (e *enumZipper[T, S]) Err() error {
+	return errors.Join(Error(e.a), Error(e.b))
+}
+
+func (e *enumZipper[T, S]) Close() error {
+	return errors.Join(Close(e.a), Close(e.b))
+}
+
 // Zip takes two enumerables and returns a enumerable with tuples of them.
 func Zip[T, S any](a Enumerable[T], b Enumerable[S]) Enumerable[tuple.T2[T, S]] {
 	return &enumZipper[T, S]{a, b}
@@ -169,7 +194,24 @@ func (e *enumMutex[T]) Value() T {
 	return e.e.Value()
 }
 
-// Synchronize makes reading the enumerable thread safe.
+func (e *enumMutex[T]) Err() error {
+	e.m.RLock()
+	defer e.m.RUnlock()
+
+	return Error(e.e)
+}
+
+func (e *enumMutex[T]) Close() error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	return Close(e.e)
+}
+
+// Synchronize makes the calls to Next and Value of the enumerable thread safe.
+// The calls are not atomic together, two goroutines may both call Next and
+// then read the same value. Use Shared or Distribute to consume an enumerable
+// from several goroutines.
 func Synchronize[T any](e Enumerable[T]) Enumerable[T] {
 	if _, ok := e.(*enumMutex[T]); ok {
 		// don't wrap an existing synchronized mutex
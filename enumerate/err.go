@@ -0,0 +1,36 @@
+package enumerate
+
+import (
+	"errors"
+	"io"
+
+	. "github.com/noxer/nox/dot"
+)
+
+// Error returns the error that ended the enumerable if it implements
+// EnumerableErr. The enumerables returned by this package report the errors
+// of their sources.
+func Error[T any](e Enumerable[T]) error {
+	if ee, ok := e.(EnumerableErr[T]); ok {
+		return ee.Err()
+	}
+
+	return nil
+}
+
+// Close closes the enumerable if it implements io.Closer. The enumerables
+// returned by this package close their sources.
+func Close[T any](e Enumerable[T]) error {
+	if c, ok := e.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// DrainClose reads all values from an enumerable, closes it and returns the
+// error that ended it or the error from closing it.
+func DrainClose[T any](e Enumerable[T]) error {
+	Drain(e)
+	return errors.Join(Error(e), Close(e))
+}
@@ -0,0 +1,184 @@
+package enumerate
+
+import (
+	"golang.org/x/exp/constraints"
+
+	. "github.com/noxer/nox/dot"
+)
+
+type enumRange[T constraints.Integer | constraints.Float] struct {
+	start, end, step T
+	cur              T
+	i                int
+	started          bool
+}
+
+func (e *enumRange[T]) Next() bool {
+	if e.started {
+		// computing the value from the index keeps floating point errors from
+		// adding up; for integers it's the same as adding step to cur
+		next := e.start + T(e.i+1)*e.step
+		if (e.step > 0 && next < e.cur) || (e.step < 0 && next > e.cur) {
+			// overflow
+			return false
+		}
+		e.i++
+		e.cur = next
+	}
+	e.started = true
+
+	if e.step > 0 {
+		return e.cur < e.end
+	}
+	return e.cur > e.end
+}
+
+func (e *enumRange[T]) Value() T {
+	return e.cur
+}
+
+// Range returns the numbers from start up to, but not including, end with the
+// distance step between them. A negative step counts down. Range panics if
+// step is zero.
+func Range[T constraints.Integer | constraints.Float](start, end, step T) Enumerable[T] {
+	if step == 0 {
+		panic("range step must not be zero")
+	}
+
+	return &enumRange[T]{start: start, end: end, step: step, cur: start}
+}
+
+type enumRepeat[T any] struct {
+	v T
+	n int
+}
+
+func (e *enumRepeat[T]) Next() bool {
+	if e.n < 0 {
+		return true
+	}
+	if e.n == 0 {
+		return false
+	}
+
+	e.n--
+	return true
+}
+
+func (e *enumRepeat[T]) Value() T {
+	return e.v
+}
+
+// Repeat returns v n times. A negative n repeats v forever.
+func Repeat[T any](v T, n int) Enumerable[T] {
+	return &enumRepeat[T]{v, n}
+}
+
+type enumCycle[T any] struct {
+	e      Enumerable[T]
+	buf    []T
+	i      int
+	cycled bool
+}
+
+func (e *enumCycle[T]) Next() bool {
+	if !e.cycled {
+		if e.e.Next() {
+			e.buf = append(e.buf, e.e.Value())
+			e.i = len(e.buf) - 1
+			return true
+		}
+
+		if Error(e.e) != nil {
+			return false
+		}
+
+		e.cycled = true
+		e.i = -1
+	}
+
+	if len(e.buf) == 0 {
+		return false
+	}
+
+	e.i = (e.i + 1) % len(e.buf)
+	return true
+}
+
+func (e *enumCycle[T]) Value() T {
+	if len(e.buf) == 0 {
+		return Default[T]()
+	}
+
+	return e.buf[e.i]
+}
+
+func (e *enumCycle[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumCycle[T]) Close() error {
+	return Close(e.e)
+}
+
+// Cycle returns the elements of e over and over again. The elements are
+// buffered while e is read the first time. Cycle ends if e is empty or ends
+// with an error.
+func Cycle[T any](e Enumerable[T]) Enumerable[T] {
+	return &enumCycle[T]{e: e}
+}
+
+type enumIterate[T any] struct {
+	cur     T
+	f       func(T) T
+	started bool
+}
+
+func (e *enumIterate[T]) Next() bool {
+	if e.started {
+		e.cur = e.f(e.cur)
+	}
+	e.started = true
+
+	return true
+}
+
+func (e *enumIterate[T]) Value() T {
+	return e.cur
+}
+
+// Iterate returns the infinite sequence seed, f(seed), f(f(seed)), ...
+func Iterate[T any](seed T, f func(T) T) Enumerable[T] {
+	return &enumIterate[T]{cur: seed, f: f}
+}
+
+type enumGenerate[T any] struct {
+	f    func() Optional[T]
+	cur  T
+	done bool
+}
+
+func (e *enumGenerate[T]) Next() bool {
+	if e.done {
+		return false
+	}
+
+	o := e.f()
+	if !o.HasValue() {
+		e.done = true
+		return false
+	}
+
+	e.cur = o.Value()
+	return true
+}
+
+func (e *enumGenerate[T]) Value() T {
+	return e.cur
+}
+
+// Generate returns the values returned by f until it returns an Optional
+// without a value.
+func Generate[T any](f func() Optional[T]) Enumerable[T] {
+	return &enumGenerate[T]{f: f}
+}
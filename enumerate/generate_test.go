@@ -0,0 +1,40 @@
+package enumerate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	checkEnum(t, Range(0, 5, 1), []int{0, 1, 2, 3, 4})
+	checkEnum(t, Range(5, 0, -2), []int{5, 3, 1})
+	checkEnum(t, Range(math.MaxInt-2, math.MaxInt, 3), []int{math.MaxInt - 2})
+}
+
+func TestRangeOverflow(t *testing.T) {
+	e := Range[int8](0, 127, 100)
+
+	var got []int8
+	for e.Next() {
+		got = append(got, e.Value())
+	}
+	if len(got) != 2 || got[0] != 0 || got[1] != 100 {
+		t.Fatalf("got %v, want [0 100]", got)
+	}
+
+	for i := 0; i < 10; i++ {
+		if e.Next() {
+			t.Fatalf("Next() = true after overflow, value %d", e.Value())
+		}
+	}
+}
+
+func TestRangeFloat(t *testing.T) {
+	got := ToSlice(Range(0.0, 1.0, 0.1))
+	if len(got) != 10 {
+		t.Fatalf("got %d values %v, want 10", len(got), got)
+	}
+	if last := got[len(got)-1]; math.Abs(last-0.9) > 1e-9 {
+		t.Fatalf("last value = %v, want 0.9", last)
+	}
+}
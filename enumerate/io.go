@@ -0,0 +1,137 @@
+package enumerate
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+
+	. "github.com/noxer/nox/dot"
+)
+
+func closeReader(r io.Reader) error {
+	if c, ok := r.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+type enumScanner struct {
+	r io.Reader
+	s *bufio.Scanner
+}
+
+func (e *enumScanner) Next() bool {
+	return e.s.Scan()
+}
+
+func (e *enumScanner) Value() string {
+	return e.s.Text()
+}
+
+func (e *enumScanner) Err() error {
+	return e.s.Err()
+}
+
+func (e *enumScanner) Close() error {
+	return closeReader(e.r)
+}
+
+// Lines returns the lines read from r without the line endings. Closing the
+// enumerable closes r if it implements io.Closer.
+func Lines(r io.Reader) EnumerableErr[string] {
+	return Split(r, bufio.ScanLines)
+}
+
+// Split returns the tokens read from r, split by split (see bufio.Scanner).
+// Closing the enumerable closes r if it implements io.Closer.
+func Split(r io.Reader, split bufio.SplitFunc) EnumerableErr[string] {
+	s := bufio.NewScanner(r)
+	s.Split(split)
+	return &enumScanner{r: r, s: s}
+}
+
+type enumCSV struct {
+	r   io.Reader
+	c   *csv.Reader
+	cur []string
+	err error
+}
+
+func (e *enumCSV) Next() bool {
+	if e.err != nil {
+		return false
+	}
+
+	e.cur, e.err = e.c.Read()
+	return e.err == nil
+}
+
+func (e *enumCSV) Value() []string {
+	return e.cur
+}
+
+func (e *enumCSV) Err() error {
+	if errors.Is(e.err, io.EOF) {
+		return nil
+	}
+
+	return e.err
+}
+
+func (e *enumCSV) Close() error {
+	return closeReader(e.r)
+}
+
+// CSVRecords returns the records read from the CSV data in r. It stops at the
+// first malformed record. Closing the enumerable closes r if it implements
+// io.Closer.
+func CSVRecords(r io.Reader) EnumerableErr[[]string] {
+	return &enumCSV{r: r, c: csv.NewReader(r)}
+}
+
+type enumJSON[T any] struct {
+	r   io.Reader
+	d   *json.Decoder
+	cur T
+	err error
+}
+
+func (e *enumJSON[T]) Next() bool {
+	if e.err != nil {
+		return false
+	}
+
+	var t T
+	if e.err = e.d.Decode(&t); e.err != nil {
+		return false
+	}
+
+	e.cur = t
+	return true
+}
+
+func (e *enumJSON[T]) Value() T {
+	return e.cur
+}
+
+func (e *enumJSON[T]) Err() error {
+	if errors.Is(e.err, io.EOF) {
+		return nil
+	}
+
+	return e.err
+}
+
+func (e *enumJSON[T]) Close() error {
+	return closeReader(e.r)
+}
+
+// JSONDecode returns the values decoded from a stream of JSON values in r,
+// like newline-delimited JSON. It stops at the first value that can't be
+// decoded. Closing the enumerable closes r if it implements io.Closer.
+func JSONDecode[T any](r io.Reader) EnumerableErr[T] {
+	return &enumJSON[T]{r: r, d: json.NewDecoder(r)}
+}
@@ -0,0 +1,259 @@
+package enumerate
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/tuple"
+)
+
+// joinTable is the hash table of the inner enumerable of a join. It is built on
+// first use.
+type joinTable[I any, K comparable] struct {
+	inner Enumerable[I]
+	key   func(I) K
+	table map[K][]I
+	err   error
+}
+
+func (t *joinTable[I, K]) build() bool {
+	if t.table == nil {
+		t.table = make(map[K][]I)
+		for t.inner.Next() {
+			val := t.inner.Value()
+			k := t.key(val)
+			t.table[k] = append(t.table[k], val)
+		}
+		t.err = Error(t.inner)
+	}
+
+	return t.err == nil
+}
+
+type enumJoin[O, I any, K comparable] struct {
+	outer    Enumerable[O]
+	outerKey func(O) K
+	t        joinTable[I, K]
+	left     bool
+
+	matches []I
+	i       int
+	cur     tuple.T2[O, Optional[I]]
+}
+
+func (e *enumJoin[O, I, K]) Next() bool {
+	if !e.t.build() {
+		return false
+	}
+
+	for {
+		if e.i < len(e.matches) {
+			e.cur.B = Success(e.matches[e.i])
+			e.i++
+			return true
+		}
+
+		if !e.outer.Next() {
+			return false
+		}
+
+		e.cur = tuple.T2[O, Optional[I]]{A: e.outer.Value()}
+		e.matches = e.t.table[e.outerKey(e.cur.A)]
+		e.i = 0
+
+		if len(e.matches) == 0 && e.left {
+			return true
+		}
+	}
+}
+
+func (e *enumJoin[O, I, K]) Value() tuple.T2[O, Optional[I]] {
+	return e.cur
+}
+
+func (e *enumJoin[O, I, K]) Err() error {
+	if e.t.err != nil {
+		return e.t.err
+	}
+
+	return Error(e.outer)
+}
+
+func (e *enumJoin[O, I, K]) Close() error {
+	return errors.Join(Close(e.outer), Close(e.t.inner))
+}
+
+// Join returns the pairs of elements of outer and inner with equal keys. It
+// reads inner into a hash table on first use and streams outer.
+func Join[O, I any, K comparable](outer Enumerable[O], inner Enumerable[I], outerKey func(O) K, innerKey func(I) K) Enumerable[tuple.T2[O, I]] {
+	joined := &enumJoin[O, I, K]{
+		outer:    outer,
+		outerKey: outerKey,
+		t:        joinTable[I, K]{inner: inner, key: innerKey},
+	}
+
+	return Map[tuple.T2[O, Optional[I]]](joined, func(t tuple.T2[O, Optional[I]]) tuple.T2[O, I] {
+		return tuple.T2[O, I]{A: t.A, B: t.B.Value()}
+	})
+}
+
+// LeftJoin is like Join, but also returns the elements of outer without a
+// matching element in inner, paired with an Optional without a value.
+func LeftJoin[O, I any, K comparable](outer Enumerable[O], inner Enumerable[I], outerKey func(O) K, innerKey func(I) K) Enumerable[tuple.T2[O, Optional[I]]] {
+	return &enumJoin[O, I, K]{
+		outer:    outer,
+		outerKey: outerKey,
+		t:        joinTable[I, K]{inner: inner, key: innerKey},
+		left:     true,
+	}
+}
+
+// CrossProduct returns all pairs of elements of a and b. It reads b into
+// memory on first use and streams a.
+func CrossProduct[A, B any](a Enumerable[A], b Enumerable[B]) Enumerable[tuple.T2[A, B]] {
+	return Join(a, b, func(A) struct{} { return struct{}{} }, func(B) struct{} { return struct{}{} })
+}
+
+type enumGroupJoin[O, I any, K comparable] struct {
+	outer    Enumerable[O]
+	outerKey func(O) K
+	t        joinTable[I, K]
+	cur      tuple.T2[O, []I]
+}
+
+func (e *enumGroupJoin[O, I, K]) Next() bool {
+	if !e.t.build() || !e.outer.Next() {
+		return false
+	}
+
+	val := e.outer.Value()
+	e.cur = tuple.T2[O, []I]{A: val, B: e.t.table[e.outerKey(val)]}
+	return true
+}
+
+func (e *enumGroupJoin[O, I, K]) Value() tuple.T2[O, []I] {
+	return e.cur
+}
+
+func (e *enumGroupJoin[O, I, K]) Err() error {
+	if e.t.err != nil {
+		return e.t.err
+	}
+
+	return Error(e.outer)
+}
+
+func (e *enumGroupJoin[O, I, K]) Close() error {
+	return errors.Join(Close(e.outer), Close(e.t.inner))
+}
+
+// GroupJoin pairs every element of outer with all elements of inner with an
+// equal key. It reads inner into a hash table on first use and streams outer.
+// The slices of matching elements are shared and must not be modified.
+func GroupJoin[O, I any, K comparable](outer Enumerable[O], inner Enumerable[I], outerKey func(O) K, innerKey func(I) K) Enumerable[tuple.T2[O, []I]] {
+	return &enumGroupJoin[O, I, K]{
+		outer:    outer,
+		outerKey: outerKey,
+		t:        joinTable[I, K]{inner: inner, key: innerKey},
+	}
+}
+
+type enumMergeJoin[O, I any, K constraints.Ordered] struct {
+	outer    Enumerable[O]
+	inner    Enumerable[I]
+	outerKey func(O) K
+	innerKey func(I) K
+
+	started  bool
+	next     I
+	hasNext  bool
+	group    []I
+	groupKey K
+	hasGroup bool
+
+	matched bool
+	i       int
+	cur     tuple.T2[O, I]
+	failed  bool
+}
+
+func (e *enumMergeJoin[O, I, K]) fetch() {
+	if e.hasNext = e.inner.Next(); e.hasNext {
+		e.next = e.inner.Value()
+	} else {
+		e.failed = Error(e.inner) != nil
+	}
+}
+
+// load reads the group of inner elements with the key k, skipping all elements
+// with smaller keys.
+func (e *enumMergeJoin[O, I, K]) load(k K) {
+	if !e.started {
+		e.started = true
+		e.fetch()
+	}
+
+	for e.hasNext && e.innerKey(e.next) < k {
+		e.fetch()
+	}
+
+	e.hasGroup = false
+	if !e.hasNext || e.innerKey(e.next) != k {
+		return
+	}
+
+	e.group = nil
+	e.groupKey, e.hasGroup = k, true
+	for e.hasNext && e.innerKey(e.next) == k {
+		e.group = append(e.group, e.next)
+		e.fetch()
+	}
+}
+
+func (e *enumMergeJoin[O, I, K]) Next() bool {
+	for {
+		if e.matched && e.i < len(e.group) {
+			e.cur.B = e.group[e.i]
+			e.i++
+			return true
+		}
+
+		if !e.outer.Next() {
+			return false
+		}
+
+		e.cur.A = e.outer.Value()
+		k := e.outerKey(e.cur.A)
+		if !e.hasGroup || e.groupKey < k {
+			e.load(k)
+		}
+
+		if e.failed {
+			return false
+		}
+
+		e.matched = e.hasGroup && e.groupKey == k
+		e.i = 0
+	}
+}
+
+func (e *enumMergeJoin[O, I, K]) Value() tuple.T2[O, I] {
+	return e.cur
+}
+
+func (e *enumMergeJoin[O, I, K]) Err() error {
+	return errors.Join(Error(e.outer), Error(e.inner))
+}
+
+func (e *enumMergeJoin[O, I, K]) Close() error {
+	return errors.Join(Close(e.outer), Close(e.inner))
+}
+
+// JoinSorted is like Join for enumerables sorted in ascending order by their
+// keys. It streams both enumerables, only holding the inner elements with the
+// current key in memory.
+func JoinSorted[O, I any, K constraints.Ordered](outer Enumerable[O], inner Enumerable[I], outerKey func(O) K, innerKey func(I) K) Enumerable[tuple.T2[O, I]] {
+	return &enumMergeJoin[O, I, K]{outer: outer, inner: inner, outerKey: outerKey, innerKey: innerKey}
+}
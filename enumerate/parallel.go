@@ -0,0 +1,127 @@
+package enumerate
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/noxer/nox"
+	. "github.com/noxer/nox/dot"
+)
+
+type parallelResult[S any] struct {
+	index int
+	val   S
+	pe    *nox.PanicError
+}
+
+type enumParallel[T, S any] struct {
+	e       Enumerable[T]
+	f       func(T) S
+	workers int
+	ordered bool
+
+	results  chan parallelResult[S]
+	buffer   map[int]S
+	inFlight int
+	pulled   int
+	yielded  int
+	drained  bool
+	cur      S
+}
+
+func (e *enumParallel[T, S]) fill() {
+	for !e.drained && e.inFlight < e.workers {
+		if !e.e.Next() {
+			e.drained = true
+			return
+		}
+
+		go e.run(e.pulled, e.e.Value())
+		e.pulled++
+		e.inFlight++
+	}
+}
+
+func (e *enumParallel[T, S]) run(index int, t T) {
+	r := parallelResult[S]{index: index}
+	defer func() {
+		if v := recover(); v != nil {
+			r.pe = &nox.PanicError{Value: v, Stack: debug.Stack()}
+		}
+		e.results <- r
+	}()
+
+	r.val = e.f(t)
+}
+
+func (e *enumParallel[T, S]) receive() parallelResult[S] {
+	r := <-e.results
+	if r.pe != nil {
+		panic(r.pe)
+	}
+	return r
+}
+
+func (e *enumParallel[T, S]) Next() bool {
+	e.fill()
+
+	if !e.ordered {
+		if e.inFlight == 0 {
+			return false
+		}
+
+		e.cur = e.receive().val
+		e.inFlight--
+		return true
+	}
+
+	for {
+		if val, ok := e.buffer[e.yielded]; ok {
+			delete(e.buffer, e.yielded)
+			e.cur = val
+			e.yielded++
+			e.inFlight--
+			return true
+		}
+
+		if e.inFlight == 0 {
+			return false
+		}
+
+		r := e.receive()
+		e.buffer[r.index] = r.val
+	}
+}
+
+func (e *enumParallel[T, S]) Value() S {
+	return e.cur
+}
+
+func (e *enumParallel[T, S]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumParallel[T, S]) Close() error {
+	return Close(e.e)
+}
+
+// ParallelMap applies a function f to every element of the enumerable e on up
+// to workers goroutines. Elements are pulled from e lazily on the calling
+// goroutine and at most workers elements are in flight at any time. If ordered
+// is true, the results are yielded in the order of e, otherwise in the order
+// they complete. A workers value < 1 uses runtime.NumCPU(). If f panics, the
+// panic is re-raised as a *nox.PanicError on the goroutine calling Next.
+func ParallelMap[T, S any](e Enumerable[T], f func(T) S, workers int, ordered bool) Enumerable[S] {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	return &enumParallel[T, S]{
+		e:       e,
+		f:       f,
+		workers: workers,
+		ordered: ordered,
+		results: make(chan parallelResult[S], workers),
+		buffer:  make(map[int]S),
+	}
+}
@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package enumerate
+
+import (
+	"iter"
+
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/tuple"
+)
+
+type enumSeq[T any] struct {
+	next func() (T, bool)
+	stop func()
+	cur  T
+}
+
+func (e *enumSeq[T]) Next() bool {
+	cur, ok := e.next()
+	if !ok {
+		e.stop()
+		return false
+	}
+
+	e.cur = cur
+	return true
+}
+
+func (e *enumSeq[T]) Value() T {
+	return e.cur
+}
+
+// Close stops the iterator.
+func (e *enumSeq[T]) Close() error {
+	e.stop()
+	return nil
+}
+
+// FromSeq creates an enumerable from an iterator. The iterator is stopped once
+// the enumerable has been read to the end or closed.
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	next, stop := iter.Pull(seq)
+	return &enumSeq[T]{next: next, stop: stop}
+}
+
+// FromSeq2 creates an enumerable of tuples from an iterator of pairs. The
+// iterator is stopped once the enumerable has been read to the end or closed.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Enumerable[tuple.T2[K, V]] {
+	next, stop := iter.Pull2(seq)
+	return &enumSeq[tuple.T2[K, V]]{
+		next: func() (tuple.T2[K, V], bool) {
+			k, v, ok := next()
+			return tuple.T2[K, V]{A: k, B: v}, ok
+		},
+		stop: stop,
+	}
+}
+
+// Seq returns an iterator over the elements of an enumerable.
+func Seq[T any](e Enumerable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e.Next() {
+			if !yield(e.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the pairs of an enumerable of tuples, like the
+// one returned by maps.Enumerate.
+func Seq2[K, V any](e Enumerable[tuple.T2[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e.Next() {
+			if !yield(e.Value().Unpack()) {
+				return
+			}
+		}
+	}
+}
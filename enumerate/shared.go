@@ -0,0 +1,109 @@
+package enumerate
+
+import (
+	"sync"
+
+	. "github.com/noxer/nox/dot"
+)
+
+// SharedEnumerable allows several goroutines to consume the same enumerable.
+// Every element is handed out exactly once.
+type SharedEnumerable[T any] struct {
+	m    sync.Mutex
+	e    Enumerable[T]
+	done bool
+	open int
+}
+
+// Shared wraps e so it can be consumed from several goroutines.
+func Shared[T any](e Enumerable[T]) *SharedEnumerable[T] {
+	return &SharedEnumerable[T]{e: e}
+}
+
+// TryNext atomically reads the next element. It returns an Optional without a
+// value once the enumerable has ended.
+func (s *SharedEnumerable[T]) TryNext() Optional[T] {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.done || !s.e.Next() {
+		s.done = true
+		return Failure[T]()
+	}
+
+	return Success(s.e.Value())
+}
+
+// Err returns the error that ended the enumerable.
+func (s *SharedEnumerable[T]) Err() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return Error(s.e)
+}
+
+// Close closes the enumerable.
+func (s *SharedEnumerable[T]) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.done = true
+	return Close(s.e)
+}
+
+func (s *SharedEnumerable[T]) release() error {
+	s.m.Lock()
+	s.open--
+	last := s.open == 0
+	s.m.Unlock()
+
+	if last {
+		return s.Close()
+	}
+	return nil
+}
+
+type enumShared[T any] struct {
+	s      *SharedEnumerable[T]
+	cur    T
+	closed bool
+}
+
+func (e *enumShared[T]) Next() bool {
+	o := e.s.TryNext()
+	e.cur = o.Value()
+	return o.HasValue()
+}
+
+func (e *enumShared[T]) Value() T {
+	return e.cur
+}
+
+func (e *enumShared[T]) Err() error {
+	return e.s.Err()
+}
+
+// Close closes the source once all enumerables returned by Distribute have
+// been closed.
+func (e *enumShared[T]) Close() error {
+	if e.closed {
+		return nil
+	}
+
+	e.closed = true
+	return e.s.release()
+}
+
+// Distribute splits e into n enumerables that can be read from different
+// goroutines, one goroutine per enumerable. Every element of e is returned by
+// exactly one of them, whichever asks for the next element first.
+func Distribute[T any](e Enumerable[T], n int) []Enumerable[T] {
+	s := Shared(e)
+	s.open = n
+
+	es := make([]Enumerable[T], n)
+	for i := range es {
+		es[i] = &enumShared[T]{s: s}
+	}
+	return es
+}
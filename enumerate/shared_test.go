@@ -0,0 +1,73 @@
+package enumerate
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/noxer/nox/dot"
+)
+
+func TestDistribute(t *testing.T) {
+	const (
+		n       = 100000
+		readers = 8
+	)
+
+	seen := make([][]int, readers)
+	wg := &sync.WaitGroup{}
+	for i, e := range Distribute(Range(0, n, 1), readers) {
+		wg.Add(1)
+		go func(i int, e Enumerable[int]) {
+			defer wg.Done()
+			for e.Next() {
+				seen[i] = append(seen[i], e.Value())
+			}
+		}(i, e)
+	}
+	wg.Wait()
+
+	counts := make([]int, n)
+	for _, vals := range seen {
+		for _, val := range vals {
+			counts[val]++
+		}
+	}
+
+	for val, count := range counts {
+		if count != 1 {
+			t.Fatalf("value %d delivered %d times, want once", val, count)
+		}
+	}
+}
+
+func TestSharedTryNext(t *testing.T) {
+	const (
+		n       = 100000
+		readers = 8
+	)
+
+	s := Shared(Range(0, n, 1))
+
+	var m sync.Mutex
+	counts := make([]int, n)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for o := s.TryNext(); o.HasValue(); o = s.TryNext() {
+				m.Lock()
+				counts[o.Value()]++
+				m.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for val, count := range counts {
+		if count != 1 {
+			t.Fatalf("value %d delivered %d times, want once", val, count)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package enumerate
+
+import (
+	"container/heap"
+	"errors"
+
+	"golang.org/x/exp/constraints"
+
+	. "github.com/noxer/nox/dot"
+)
+
+type mergeItem[T any, K constraints.Ordered] struct {
+	val T
+	key K
+	src int
+}
+
+type mergeHeap[T any, K constraints.Ordered] []mergeItem[T, K]
+
+func (h mergeHeap[T, K]) Len() int {
+	return len(h)
+}
+
+func (h mergeHeap[T, K]) Less(a, b int) bool {
+	if h[a].key == h[b].key {
+		return h[a].src < h[b].src
+	}
+	return h[a].key < h[b].key
+}
+
+func (h mergeHeap[T, K]) Swap(a, b int) {
+	h[a], h[b] = h[b], h[a]
+}
+
+func (h *mergeHeap[T, K]) Push(x any) {
+	*h = append(*h, x.(mergeItem[T, K]))
+}
+
+func (h *mergeHeap[T, K]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+type enumMerge[T any, K constraints.Ordered] struct {
+	es      []Enumerable[T]
+	key     func(T) K
+	h       mergeHeap[T, K]
+	started bool
+	last    int
+	cur     T
+	err     error
+}
+
+func (e *enumMerge[T, K]) pull(src int) {
+	if e.es[src].Next() {
+		val := e.es[src].Value()
+		heap.Push(&e.h, mergeItem[T, K]{val: val, key: e.key(val), src: src})
+		return
+	}
+
+	if err := Error(e.es[src]); err != nil && e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *enumMerge[T, K]) Next() bool {
+	if !e.started {
+		e.started = true
+		for src := range e.es {
+			e.pull(src)
+		}
+	} else if e.last >= 0 {
+		e.pull(e.last)
+	}
+
+	if e.err != nil || len(e.h) == 0 {
+		e.last = -1
+		return false
+	}
+
+	item := heap.Pop(&e.h).(mergeItem[T, K])
+	e.cur, e.last = item.val, item.src
+	return true
+}
+
+func (e *enumMerge[T, K]) Value() T {
+	return e.cur
+}
+
+func (e *enumMerge[T, K]) Err() error {
+	return e.err
+}
+
+func (e *enumMerge[T, K]) Close() error {
+	errs := make([]error, len(e.es))
+	for i, es := range e.es {
+		errs[i] = Close(es)
+	}
+
+	return errors.Join(errs...)
+}
+
+// MergeSorted merges enumerables sorted in ascending order into one sorted
+// enumerable. Equal elements are returned in the order of the enumerables. It
+// only holds one element per enumerable in memory and stops at the first
+// enumerable that ends with an error.
+func MergeSorted[T constraints.Ordered](es ...Enumerable[T]) Enumerable[T] {
+	return MergeSortedBy(func(t T) T { return t }, es...)
+}
+
+// MergeSortedBy is like MergeSorted for enumerables sorted in ascending order
+// by key(e).
+func MergeSortedBy[T any, K constraints.Ordered](key func(T) K, es ...Enumerable[T]) Enumerable[T] {
+	return &enumMerge[T, K]{es: es, key: key, last: -1}
+}
+
+type enumDistinct[T constraints.Ordered] struct {
+	e       Enumerable[T]
+	cur     T
+	started bool
+}
+
+func (e *enumDistinct[T]) Next() bool {
+	for e.e.Next() {
+		if val := e.e.Value(); !e.started || val != e.cur {
+			e.started = true
+			e.cur = val
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *enumDistinct[T]) Value() T {
+	return e.cur
+}
+
+func (e *enumDistinct[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumDistinct[T]) Close() error {
+	return Close(e.e)
+}
+
+// DistinctSorted drops the elements of a sorted enumerable that are equal to
+// the previous one.
+func DistinctSorted[T constraints.Ordered](e Enumerable[T]) Enumerable[T] {
+	return &enumDistinct[T]{e: e}
+}
+
+// sortedPair holds the current elements of two sorted enumerables.
+type sortedPair[T constraints.Ordered] struct {
+	a, b         Enumerable[T]
+	va, vb       T
+	hasA, hasB   bool
+	needA, needB bool
+	failed       bool
+	cur          T
+}
+
+func (e *sortedPair[T]) advance() {
+	if e.needA {
+		e.needA = false
+		if e.hasA = e.a.Next(); e.hasA {
+			e.va = e.a.Value()
+		}
+	}
+
+	if e.needB {
+		e.needB = false
+		if e.hasB = e.b.Next(); e.hasB {
+			e.vb = e.b.Value()
+		} else {
+			e.failed = Error(e.b) != nil
+		}
+	}
+}
+
+func (e *sortedPair[T]) Value() T {
+	return e.cur
+}
+
+func (e *sortedPair[T]) Err() error {
+	return errors.Join(Error(e.a), Error(e.b))
+}
+
+func (e *sortedPair[T]) Close() error {
+	return errors.Join(Close(e.a), Close(e.b))
+}
+
+type enumIntersect[T constraints.Ordered] struct {
+	sortedPair[T]
+}
+
+func (e *enumIntersect[T]) Next() bool {
+	for e.advance(); e.hasA && e.hasB; e.advance() {
+		switch {
+		case e.va < e.vb:
+			e.needA = true
+		case e.va > e.vb:
+			e.needB = true
+		default:
+			e.cur = e.va
+			e.needA, e.needB = true, true
+			return true
+		}
+	}
+
+	return false
+}
+
+// IntersectSorted returns the elements found in both of the sorted enumerables
+// a and b. Duplicate elements are matched one to one.
+func IntersectSorted[T constraints.Ordered](a, b Enumerable[T]) Enumerable[T] {
+	return &enumIntersect[T]{sortedPair[T]{a: a, b: b, needA: true, needB: true}}
+}
+
+type enumDifference[T constraints.Ordered] struct {
+	sortedPair[T]
+}
+
+func (e *enumDifference[T]) Next() bool {
+	for e.advance(); e.hasA && !e.failed; e.advance() {
+		switch {
+		case !e.hasB || e.va < e.vb:
+			e.cur = e.va
+			e.needA = true
+			return true
+		case e.va > e.vb:
+			e.needB = true
+		default:
+			e.needA, e.needB = true, true
+		}
+	}
+
+	return false
+}
+
+// DifferenceSorted returns the elements of the sorted enumerable a that are not
+// found in the sorted enumerable b. Duplicate elements are matched one to one.
+// It stops if b ends with an error.
+func DifferenceSorted[T constraints.Ordered](a, b Enumerable[T]) Enumerable[T] {
+	return &enumDifference[T]{sortedPair[T]{a: a, b: b, needA: true, needB: true}}
+}
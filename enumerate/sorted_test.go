@@ -0,0 +1,56 @@
+package enumerate
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/slice"
+)
+
+var errBroken = errors.New("broken")
+
+// failingEnum returns its values and then ends with errBroken.
+type failingEnum struct {
+	countingEnum
+}
+
+func (e *failingEnum) Err() error {
+	if e.i < len(e.vals) {
+		return nil
+	}
+	return errBroken
+}
+
+func TestSetOperationsSorted(t *testing.T) {
+	s := slice.Enumerate[int]
+
+	checkEnum(t, MergeSorted(s([]int{1, 4, 7}), s(nil), s([]int{0, 4, 9})), []int{0, 1, 4, 4, 7, 9})
+	checkEnum(t, DistinctSorted(s([]int{1, 1, 2, 3, 3})), []int{1, 2, 3})
+	checkEnum(t, IntersectSorted(s([]int{1, 1, 2, 4, 6}), s([]int{1, 3, 4, 6, 7})), []int{1, 4, 6})
+	checkEnum(t, DifferenceSorted(s([]int{1, 1, 2, 4, 8}), s([]int{1, 3, 4, 6})), []int{1, 2, 8})
+}
+
+func TestSortedStopOnError(t *testing.T) {
+	s := slice.Enumerate[int]
+
+	tests := []struct {
+		name string
+		e    Enumerable[int]
+		want []int
+	}{
+		{"merge", MergeSorted[int](s([]int{1, 2, 3}), &failingEnum{*counting()}), nil},
+		{"intersect", IntersectSorted[int](s([]int{1, 2, 3}), &failingEnum{*counting(1)}), []int{1}},
+		{"difference", DifferenceSorted[int](s([]int{1, 2, 3}), &failingEnum{*counting()}), nil},
+		{"difference after match", DifferenceSorted[int](s([]int{1, 2, 3}), &failingEnum{*counting(2)}), []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkEnum(t, tt.e, tt.want)
+			if err := Error(tt.e); !errors.Is(err, errBroken) {
+				t.Fatalf("Error() = %v, want %v", err, errBroken)
+			}
+		})
+	}
+}
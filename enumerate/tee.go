@@ -0,0 +1,165 @@
+package enumerate
+
+import (
+	"sync"
+
+	. "github.com/noxer/nox/dot"
+)
+
+// teeSource is the buffer shared by the enumerables returned from Tee. It only
+// keeps the elements that haven't been read by all of them.
+type teeSource[T any] struct {
+	m      sync.Mutex
+	e      Enumerable[T]
+	buf    []T
+	offset int // position of buf[0]
+	done   bool
+	pos    []int // position of the next element of each reader, -1 if closed
+}
+
+func (s *teeSource[T]) next(reader int) (T, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	pos := s.pos[reader]
+	if pos < 0 {
+		return Default[T](), false
+	}
+
+	if pos-s.offset == len(s.buf) {
+		if s.done || !s.e.Next() {
+			s.done = true
+			return Default[T](), false
+		}
+		s.buf = append(s.buf, s.e.Value())
+	}
+
+	val := s.buf[pos-s.offset]
+	s.pos[reader]++
+	s.trim()
+
+	return val, true
+}
+
+// trim drops the elements that have been read by all open readers.
+func (s *teeSource[T]) trim() {
+	min := -1
+	for _, pos := range s.pos {
+		if pos >= 0 && (min < 0 || pos < min) {
+			min = pos
+		}
+	}
+
+	if min < 0 {
+		s.buf, s.offset = nil, 0
+		return
+	}
+
+	if drop := min - s.offset; drop > 0 {
+		var zero T
+		for i := 0; i < drop; i++ {
+			s.buf[i] = zero
+		}
+		s.buf = s.buf[drop:]
+		s.offset = min
+	}
+}
+
+func (s *teeSource[T]) err() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return Error(s.e)
+}
+
+func (s *teeSource[T]) close(reader int) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.pos[reader] < 0 {
+		return nil
+	}
+
+	s.pos[reader] = -1
+	s.trim()
+
+	for _, pos := range s.pos {
+		if pos >= 0 {
+			return nil
+		}
+	}
+
+	return Close(s.e)
+}
+
+type enumTee[T any] struct {
+	s      *teeSource[T]
+	reader int
+	cur    T
+}
+
+func (e *enumTee[T]) Next() bool {
+	val, ok := e.s.next(e.reader)
+	e.cur = val
+	return ok
+}
+
+func (e *enumTee[T]) Value() T {
+	return e.cur
+}
+
+func (e *enumTee[T]) Err() error {
+	return e.s.err()
+}
+
+// Close stops this enumerable from holding back elements in the buffer. The
+// source is closed once all enumerables have been closed.
+func (e *enumTee[T]) Close() error {
+	return e.s.close(e.reader)
+}
+
+// Tee splits e into n independent enumerables returning the same elements.
+// Elements are buffered until all enumerables have read them, so the memory
+// used depends on how far the enumerables are apart. The enumerables may be
+// read from different goroutines, but each of them only from one at a time.
+// Closing an enumerable releases its hold on the buffer.
+func Tee[T any](e Enumerable[T], n int) []Enumerable[T] {
+	s := &teeSource[T]{e: e, pos: make([]int, n)}
+
+	es := make([]Enumerable[T], n)
+	for i := range es {
+		es[i] = &enumTee[T]{s: s, reader: i}
+	}
+	return es
+}
+
+type enumPeek[T any] struct {
+	e Enumerable[T]
+	f func(T)
+}
+
+func (e *enumPeek[T]) Next() bool {
+	if !e.e.Next() {
+		return false
+	}
+
+	e.f(e.e.Value())
+	return true
+}
+
+func (e *enumPeek[T]) Value() T {
+	return e.e.Value()
+}
+
+func (e *enumPeek[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumPeek[T]) Close() error {
+	return Close(e.e)
+}
+
+// Peek calls f for every element of e as it passes through.
+func Peek[T any](e Enumerable[T], f func(T)) Enumerable[T] {
+	return &enumPeek[T]{e, f}
+}
@@ -0,0 +1,204 @@
+package enumerate
+
+import (
+	. "github.com/noxer/nox/dot"
+	"github.com/noxer/nox/tuple"
+)
+
+type enumChunk[T any] struct {
+	e     Enumerable[T]
+	n     int
+	reuse bool
+	cur   []T
+}
+
+func (e *enumChunk[T]) Next() bool {
+	if e.reuse && e.cur != nil {
+		e.cur = e.cur[:0]
+	} else {
+		e.cur = make([]T, 0, e.n)
+	}
+
+	for len(e.cur) < e.n && e.e.Next() {
+		e.cur = append(e.cur, e.e.Value())
+	}
+
+	return len(e.cur) > 0
+}
+
+func (e *enumChunk[T]) Value() []T {
+	return e.cur
+}
+
+func (e *enumChunk[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumChunk[T]) Close() error {
+	return Close(e.e)
+}
+
+// Chunk groups the elements of e into slices of n elements. The last slice
+// may be shorter.
+func Chunk[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	if n < 1 {
+		panic("chunk size must be positive")
+	}
+
+	return &enumChunk[T]{e: e, n: n}
+}
+
+// ChunkReuse is like Chunk, but reuses the same slice for all chunks. The
+// slice returned by Value is only valid until the next call to Next.
+func ChunkReuse[T any](e Enumerable[T], n int) Enumerable[[]T] {
+	if n < 1 {
+		panic("chunk size must be positive")
+	}
+
+	return &enumChunk[T]{e: e, n: n, reuse: true}
+}
+
+type enumSliding[T any] struct {
+	e     Enumerable[T]
+	size  int
+	step  int
+	reuse bool
+	buf   []T
+	cur   []T
+}
+
+func (e *enumSliding[T]) Next() bool {
+	if e.buf == nil {
+		e.buf = make([]T, 0, e.size)
+	} else if e.step < e.size {
+		n := copy(e.buf, e.buf[e.step:])
+		e.buf = e.buf[:n]
+	} else {
+		e.buf = e.buf[:0]
+		for i := e.size; i < e.step; i++ {
+			if !e.e.Next() {
+				return false
+			}
+		}
+	}
+
+	for len(e.buf) < e.size {
+		if !e.e.Next() {
+			return false
+		}
+		e.buf = append(e.buf, e.e.Value())
+	}
+
+	if e.reuse {
+		e.cur = e.buf
+	} else {
+		e.cur = append([]T(nil), e.buf...)
+	}
+	return true
+}
+
+func (e *enumSliding[T]) Value() []T {
+	return e.cur
+}
+
+func (e *enumSliding[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumSliding[T]) Close() error {
+	return Close(e.e)
+}
+
+// Sliding returns windows of size consecutive elements of e, moving the window
+// by step elements each time. Elements that don't fill a whole window at the
+// end of e are dropped.
+func Sliding[T any](e Enumerable[T], size, step int) Enumerable[[]T] {
+	if size < 1 || step < 1 {
+		panic("window size and step must be positive")
+	}
+
+	return &enumSliding[T]{e: e, size: size, step: step}
+}
+
+// SlidingReuse is like Sliding, but reuses the same slice for all windows. The
+// slice returned by Value is only valid until the next call to Next.
+func SlidingReuse[T any](e Enumerable[T], size, step int) Enumerable[[]T] {
+	if size < 1 || step < 1 {
+		panic("window size and step must be positive")
+	}
+
+	return &enumSliding[T]{e: e, size: size, step: step, reuse: true}
+}
+
+type enumPairwise[T any] struct {
+	e       Enumerable[T]
+	started bool
+	cur     tuple.T2[T, T]
+}
+
+func (e *enumPairwise[T]) Next() bool {
+	if !e.started {
+		e.started = true
+		if !e.e.Next() {
+			return false
+		}
+		e.cur.B = e.e.Value()
+	}
+
+	if !e.e.Next() {
+		return false
+	}
+
+	e.cur.A, e.cur.B = e.cur.B, e.e.Value()
+	return true
+}
+
+func (e *enumPairwise[T]) Value() tuple.T2[T, T] {
+	return e.cur
+}
+
+func (e *enumPairwise[T]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumPairwise[T]) Close() error {
+	return Close(e.e)
+}
+
+// Pairwise returns tuples of each element of e and the one following it.
+func Pairwise[T any](e Enumerable[T]) Enumerable[tuple.T2[T, T]] {
+	return &enumPairwise[T]{e: e}
+}
+
+type enumScan[T, S any] struct {
+	e   Enumerable[T]
+	f   func(S, T) S
+	acc S
+}
+
+func (e *enumScan[T, S]) Next() bool {
+	if !e.e.Next() {
+		return false
+	}
+
+	e.acc = e.f(e.acc, e.e.Value())
+	return true
+}
+
+func (e *enumScan[T, S]) Value() S {
+	return e.acc
+}
+
+func (e *enumScan[T, S]) Err() error {
+	return Error(e.e)
+}
+
+func (e *enumScan[T, S]) Close() error {
+	return Close(e.e)
+}
+
+// Scan combines the elements of e with f like Fold, but returns every
+// intermediate value of the accumulator.
+func Scan[T, S any](e Enumerable[T], init S, f func(S, T) S) Enumerable[S] {
+	return &enumScan[T, S]{e: e, f: f, acc: init}
+}
@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package list
+
+import "iter"
+
+// All returns an iterator over the elements of the list.
+func (l *Linked[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for lnk := l.first; lnk != nil; lnk = lnk.next {
+			if !yield(lnk.val) {
+				return
+			}
+		}
+	}
+}
@@ -99,23 +99,40 @@ func ProdValues[K comparable, V Number](m map[K]V) (sum V) {
 }
 
 type mapEnumerator[K comparable, V any] struct {
-	m    map[K]V
-	keys []K
+	m       map[K]V
+	keys    []K
+	started bool
 }
 
-func (e mapEnumerator[K, V]) Next() bool {
-	switch len(e.keys) {
-	case 1:
-		e.keys = nil
-		fallthrough
-	case 0:
+func (e *mapEnumerator[K, V]) Next() bool {
+	if !e.started {
+		e.started = true
+		return len(e.keys) > 0
+	}
+
+	if len(e.keys) == 0 {
 		return false
 	}
 
 	e.keys = e.keys[1:]
-	return true
+	return len(e.keys) > 0
 }
 
-func (e mapEnumerator[K, V]) Value() tuple.T2[K, V] {
+func (e *mapEnumerator[K, V]) Value() tuple.T2[K, V] {
+	if len(e.keys) == 0 {
+		return tuple.T2[K, V]{}
+	}
+
 	return tuple.T2[K, V]{A: e.keys[0], B: e.m[e.keys[0]]}
 }
+
+// Enumerate creates an enumerable of the unsorted Key-Value pairs of m.
+func Enumerate[K comparable, V any](m map[K]V) Enumerable[tuple.T2[K, V]] {
+	return &mapEnumerator[K, V]{m: m, keys: Keys(m)}
+}
+
+// EnumerateSorted creates an enumerable of the Key-Value pairs of m sorted by
+// the keys.
+func EnumerateSorted[K constraints.Ordered, V any](m map[K]V) Enumerable[tuple.T2[K, V]] {
+	return &mapEnumerator[K, V]{m: m, keys: SortedKeys(m)}
+}
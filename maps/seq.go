@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package maps
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// All returns an iterator over the unsorted Key-Value pairs of m.
+func All[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the Key-Value pairs of m sorted by the keys.
+func Sorted[K constraints.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range SortedKeys(m) {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
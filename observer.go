@@ -0,0 +1,119 @@
+package nox
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// TaskInfo describes a task and the state of its batch when an Observer is
+// called.
+type TaskInfo struct {
+	// Index is the index of the task in the list of tasks.
+	Index int
+	// Duration is the time the task took. It is zero for TaskStarted.
+	Duration time.Duration
+	// Err is the error of the task. It is only set for TaskFailed.
+	Err error
+	// Queued is the number of tasks of the batch that haven't been started.
+	Queued int
+	// Active is the number of tasks of the batch that are running.
+	Active int
+}
+
+// Observer gets notified about the progress of the tasks processed by the
+// concurrent helpers. The methods are called from the worker goroutines and
+// must be safe for concurrent use.
+type Observer interface {
+	TaskStarted(info TaskInfo)
+	TaskFinished(info TaskInfo)
+	TaskFailed(info TaskInfo)
+}
+
+// ObserverFuncs implements Observer with a function for each event. Functions
+// that are nil are not called.
+type ObserverFuncs struct {
+	Started  func(TaskInfo)
+	Finished func(TaskInfo)
+	Failed   func(TaskInfo)
+}
+
+// TaskStarted calls o.Started.
+func (o ObserverFuncs) TaskStarted(info TaskInfo) {
+	if o.Started != nil {
+		o.Started(info)
+	}
+}
+
+// TaskFinished calls o.Finished.
+func (o ObserverFuncs) TaskFinished(info TaskInfo) {
+	if o.Finished != nil {
+		o.Finished(info)
+	}
+}
+
+// TaskFailed calls o.Failed.
+func (o ObserverFuncs) TaskFailed(info TaskInfo) {
+	if o.Failed != nil {
+		o.Failed(info)
+	}
+}
+
+// Observe reports the progress of the tasks to obs.
+func Observe(obs Observer) Option {
+	return func(o *options) {
+		o.observer = obs
+	}
+}
+
+// tracker keeps the counters of a batch and reports to the observer. A nil
+// tracker does nothing.
+type tracker struct {
+	obs     Observer
+	tasks   int
+	started atomic.Int64
+	active  atomic.Int64
+}
+
+func newTracker(obs Observer, tasks int) *tracker {
+	if obs == nil {
+		return nil
+	}
+
+	return &tracker{obs: obs, tasks: tasks}
+}
+
+func (t *tracker) start(index int) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	started := t.started.Add(1)
+	active := t.active.Add(1)
+	t.obs.TaskStarted(TaskInfo{
+		Index:  index,
+		Queued: t.tasks - int(started),
+		Active: int(active),
+	})
+
+	return time.Now()
+}
+
+func (t *tracker) finish(index int, start time.Time, err error) {
+	if t == nil {
+		return
+	}
+
+	info := TaskInfo{
+		Index:    index,
+		Duration: time.Since(start),
+		Err:      err,
+		Active:   int(t.active.Add(-1)),
+		Queued:   t.tasks - int(t.started.Load()),
+	}
+
+	if err != nil {
+		t.obs.TaskFailed(info)
+	} else {
+		t.obs.TaskFinished(info)
+	}
+}
@@ -0,0 +1,40 @@
+package nox
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error reported for a task that panicked.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+// Error returns the panic value and the stack trace.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// catch calls f and returns the recovered panic, if f panics.
+func catch(f func()) (pe *PanicError) {
+	defer func() {
+		if e := recover(); e != nil {
+			pe = &PanicError{Value: e, Stack: debug.Stack()}
+		}
+	}()
+
+	f()
+	return nil
+}
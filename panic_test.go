@@ -0,0 +1,112 @@
+package nox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+)
+
+func TestConcurrentCtxPanicError(t *testing.T) {
+	results, err := ConcurrentCtxN(context.Background(), []int{1, 2, 3}, func(ctx context.Context, i int) (int, error) {
+		if i == 2 {
+			panic("boom")
+		}
+		return i, nil
+	}, 3, CollectAll())
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("err = %v, want *PanicError", err)
+	}
+	if pe.Value != "boom" || len(pe.Stack) == 0 {
+		t.Fatalf("PanicError = %v, %q; want boom with stack", pe.Value, pe.Stack)
+	}
+
+	if !results[1].As(&pe) {
+		t.Fatalf("results[1] error = %v, want *PanicError", results[1].Error())
+	}
+	if !results[0].Success() || !results[2].Success() {
+		t.Fatalf("other tasks failed: %v, %v", results[0].Error(), results[2].Error())
+	}
+}
+
+func TestConcurrentCtxRepanic(t *testing.T) {
+	defer func() {
+		pe, ok := recover().(*PanicError)
+		if !ok || pe.Value != "boom" {
+			t.Fatalf("recovered %v, want *PanicError with boom", pe)
+		}
+	}()
+
+	ConcurrentCtxN(context.Background(), []int{1, 2, 3}, func(ctx context.Context, i int) (int, error) {
+		if i == 2 {
+			panic("boom")
+		}
+		return i, nil
+	}, 3, Repanic())
+
+	t.Fatal("ConcurrentCtx didn't panic")
+}
+
+func TestConcurrentNowPanic(t *testing.T) {
+	defer func() {
+		if _, ok := recover().(*PanicError); !ok {
+			t.Fatal("panic is not a *PanicError")
+		}
+	}()
+
+	ConcurrentNowN([]int{1, 2, 3}, func(i int) int {
+		if i == 2 {
+			panic("boom")
+		}
+		return i
+	}, 3)
+
+	t.Fatal("ConcurrentNowN didn't panic")
+}
+
+func TestPoolSubmitWaitPanic(t *testing.T) {
+	p := NewPool(1, 0)
+	defer p.Shutdown(context.Background())
+
+	err := p.SubmitWait(context.Background(), func() { panic("boom") })
+
+	var pe *PanicError
+	if !errors.As(err, &pe) || pe.Value != "boom" {
+		t.Fatalf("SubmitWait() = %v, want *PanicError with boom", err)
+	}
+
+	if err := p.SubmitWait(context.Background(), func() {}); err != nil {
+		t.Fatalf("SubmitWait() after panic = %v, want nil", err)
+	}
+}
+
+func TestPoolSubmitPanic(t *testing.T) {
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(io.Discard)
+
+	p := NewPool(1, 2)
+	if err := p.Submit(func() { panic("unhandled") }); err != nil {
+		t.Fatalf("Submit() = %v, want nil", err)
+	}
+
+	handled := make(chan any, 1)
+	if err := p.SubmitWait(context.Background(), func() {
+		p.HandlePanic(func(pe *PanicError) { handled <- pe.Value })
+	}); err != nil {
+		t.Fatalf("SubmitWait() = %v, want nil", err)
+	}
+
+	if err := p.Submit(func() { panic("handled") }); err != nil {
+		t.Fatalf("Submit() = %v, want nil", err)
+	}
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v, want nil", err)
+	}
+
+	if v := <-handled; v != "handled" {
+		t.Fatalf("handler got %v, want handled", v)
+	}
+}
@@ -0,0 +1,255 @@
+package nox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// ErrPoolClosed is returned when submitting a task to a pool that has been
+	// shut down.
+	ErrPoolClosed = errors.New("pool is shut down")
+
+	// ErrQueueFull is returned by Pool.Submit if the queue of the pool is full.
+	ErrQueueFull = errors.New("pool queue is full")
+)
+
+// Pool is a long-lived worker pool. Tasks are queued in a bounded queue and
+// processed by a resizable number of workers.
+type Pool struct {
+	m     sync.RWMutex
+	tasks chan func()
+
+	sizeM sync.Mutex
+	size  int
+	stop  chan struct{}
+
+	wg      sync.WaitGroup
+	closing chan struct{}
+
+	panicHandler atomic.Pointer[func(*PanicError)]
+}
+
+// NewPool creates a new pool with the given number of workers and a queue
+// that holds up to queue tasks.
+func NewPool(workers, queue int) *Pool {
+	p := &Pool{
+		tasks:   make(chan func(), queue),
+		stop:    make(chan struct{}),
+		closing: make(chan struct{}),
+	}
+	p.Resize(workers)
+	return p
+}
+
+func (p *Pool) worker() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case f, ok := <-p.tasks:
+			if !ok {
+				return
+			}
+			p.run(f)
+
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// drain runs the queued tasks until the queue is closed. Unlike worker it
+// can't be stopped by Resize.
+func (p *Pool) drain() {
+	defer p.wg.Done()
+
+	for f := range p.tasks {
+		p.run(f)
+	}
+}
+
+func (p *Pool) run(f func()) {
+	pe := catch(f)
+	if pe == nil {
+		return
+	}
+
+	if h := p.panicHandler.Load(); h != nil {
+		(*h)(pe)
+		return
+	}
+
+	log.Printf("recovered panic in pool task: %v", pe)
+}
+
+// HandlePanic sets a handler that is called for tasks submitted with Submit
+// that panicked. Without a handler, the panic is logged with the log package.
+func (p *Pool) HandlePanic(f func(*PanicError)) {
+	p.panicHandler.Store(&f)
+}
+
+func (p *Pool) isClosing() bool {
+	select {
+	case <-p.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Size returns the number of workers of the pool.
+func (p *Pool) Size() int {
+	p.sizeM.Lock()
+	defer p.sizeM.Unlock()
+
+	return p.size
+}
+
+// Resize changes the number of workers of the pool. Removed workers finish
+// their current task before they stop. Resizing a pool that has been shut down
+// has no effect.
+func (p *Pool) Resize(n int) {
+	p.sizeM.Lock()
+	defer p.sizeM.Unlock()
+
+	if p.isClosing() {
+		return
+	}
+
+	if n < 0 {
+		n = 0
+	}
+
+	for ; p.size < n; p.size++ {
+		p.wg.Add(1)
+		go p.worker()
+	}
+
+	if p.size > n {
+		go p.shrink(p.size - n)
+		p.size = n
+	}
+}
+
+func (p *Pool) shrink(n int) {
+	for i := 0; i < n; i++ {
+		select {
+		case p.stop <- struct{}{}:
+		case <-p.closing:
+			return
+		}
+	}
+}
+
+// Submit queues f to be processed by the pool. It doesn't block and returns
+// ErrQueueFull if there is no room for f in the queue.
+func (p *Pool) Submit(f func()) error {
+	p.m.RLock()
+	defer p.m.RUnlock()
+
+	if p.isClosing() {
+		return ErrPoolClosed
+	}
+
+	select {
+	case p.tasks <- f:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// SubmitWait queues f to be processed by the pool and waits for it to finish.
+// It returns the error of ctx if ctx is done before f finished. In that case
+// f is not run, unless it was already started. If f panics, the panic is
+// returned as a *PanicError.
+func (p *Pool) SubmitWait(ctx context.Context, f func()) error {
+	var (
+		ran  bool
+		pe   *PanicError
+		done = make(chan struct{})
+	)
+
+	err := p.enqueue(ctx, func() {
+		defer close(done)
+
+		if ctx.Err() == nil {
+			ran = true
+			pe = catch(f)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-done:
+		if !ran {
+			return ctx.Err()
+		}
+		if pe != nil {
+			return pe
+		}
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue queues f, waiting for room in the queue until ctx is done.
+func (p *Pool) enqueue(ctx context.Context, f func()) error {
+	p.m.RLock()
+	defer p.m.RUnlock()
+
+	if p.isClosing() {
+		return ErrPoolClosed
+	}
+
+	select {
+	case p.tasks <- f:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.closing:
+		return ErrPoolClosed
+	}
+}
+
+// Shutdown stops accepting new tasks and waits for the queued tasks to be
+// processed. If the pool has no workers, a temporary one is started to drain
+// the queue. Afterwards the pool has no workers. It returns the error of ctx if
+// ctx is done before all workers have finished.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.sizeM.Lock()
+	if !p.isClosing() {
+		close(p.closing)
+		if p.size == 0 {
+			p.wg.Add(1)
+			go p.drain()
+		}
+		p.size = 0
+
+		p.m.Lock()
+		close(p.tasks)
+		p.m.Unlock()
+	}
+	p.sizeM.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,127 @@
+package nox
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPoolResize(t *testing.T) {
+	p := NewPool(2, 0)
+	defer p.Shutdown(context.Background())
+
+	if n := p.Size(); n != 2 {
+		t.Fatalf("Size() = %d, want 2", n)
+	}
+
+	p.Resize(5)
+	if n := p.Size(); n != 5 {
+		t.Fatalf("Size() = %d, want 5", n)
+	}
+
+	p.Resize(1)
+	if n := p.Size(); n != 1 {
+		t.Fatalf("Size() = %d, want 1", n)
+	}
+
+	if err := p.SubmitWait(context.Background(), func() {}); err != nil {
+		t.Fatalf("SubmitWait() = %v, want nil", err)
+	}
+}
+
+func TestPoolQueueFull(t *testing.T) {
+	p := NewPool(0, 1)
+
+	if err := p.Submit(func() {}); err != nil {
+		t.Fatalf("first Submit() = %v, want nil", err)
+	}
+	if err := p.Submit(func() {}); err != ErrQueueFull {
+		t.Fatalf("second Submit() = %v, want %v", err, ErrQueueFull)
+	}
+
+	p.Resize(1)
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v, want nil", err)
+	}
+}
+
+func TestPoolShutdown(t *testing.T) {
+	p := NewPool(2, 10)
+
+	var done atomic.Int32
+	for i := 0; i < 10; i++ {
+		if err := p.Submit(func() { done.Add(1) }); err != nil {
+			t.Fatalf("Submit() = %v, want nil", err)
+		}
+	}
+
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v, want nil", err)
+	}
+	if n := done.Load(); n != 10 {
+		t.Fatalf("%d queued tasks processed, want 10", n)
+	}
+	if n := p.Size(); n != 0 {
+		t.Fatalf("Size() = %d after Shutdown, want 0", n)
+	}
+
+	p.Resize(3)
+	if n := p.Size(); n != 0 {
+		t.Fatalf("Size() = %d after Resize on closed pool, want 0", n)
+	}
+
+	if err := p.Submit(func() {}); err != ErrPoolClosed {
+		t.Fatalf("Submit() = %v, want %v", err, ErrPoolClosed)
+	}
+	if err := p.SubmitWait(context.Background(), func() {}); err != ErrPoolClosed {
+		t.Fatalf("SubmitWait() = %v, want %v", err, ErrPoolClosed)
+	}
+}
+
+func TestPoolShutdownWithoutWorkers(t *testing.T) {
+	p := NewPool(1, 10)
+	p.Resize(0)
+
+	var done atomic.Int32
+	for i := 0; i < 5; i++ {
+		if err := p.Submit(func() { done.Add(1) }); err != nil {
+			t.Fatalf("Submit() = %v, want nil", err)
+		}
+	}
+
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v, want nil", err)
+	}
+	if n := done.Load(); n != 5 {
+		t.Fatalf("%d queued tasks processed, want 5", n)
+	}
+}
+
+func TestOnPool(t *testing.T) {
+	p := NewPool(2, 0)
+	tasks := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	square := func(i int) int { return i * i }
+
+	check := func(results []int) {
+		t.Helper()
+		for i, r := range results {
+			if want := square(tasks[i]); r != want {
+				t.Errorf("results[%d] = %d, want %d", i, r, want)
+			}
+		}
+	}
+
+	check(ConcurrentNowN(tasks, square, 3, OnPool(p)))
+
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v, want nil", err)
+	}
+
+	// a closed pool runs the tasks on the calling goroutine
+	check(ConcurrentNowN(tasks, square, 3, OnPool(p)))
+
+	// so does a pool without workers
+	empty := NewPool(0, 0)
+	defer empty.Shutdown(context.Background())
+	check(ConcurrentNowN(tasks, square, 3, OnPool(empty)))
+}
@@ -0,0 +1,192 @@
+// Package retry calls functions repeatedly until they succeed, waiting between
+// the attempts according to a backoff policy.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	. "github.com/noxer/nox/dot"
+)
+
+// Clock provides the time to the retry loop. It can be replaced to test code
+// using retry without sleeping.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Policy decides how long to wait before the next attempt.
+type Policy interface {
+	// Delay returns the delay before retry number attempt (starting with 1).
+	// prev is the delay returned for the previous retry, or 0.
+	Delay(attempt int, prev time.Duration) time.Duration
+}
+
+// PolicyFunc implements Policy with a function.
+type PolicyFunc func(attempt int, prev time.Duration) time.Duration
+
+// Delay calls f.
+func (f PolicyFunc) Delay(attempt int, prev time.Duration) time.Duration {
+	return f(attempt, prev)
+}
+
+// Constant waits the same delay d before every retry.
+func Constant(d time.Duration) Policy {
+	return PolicyFunc(func(int, time.Duration) time.Duration {
+		return d
+	})
+}
+
+// Exponential doubles the delay for every retry, starting with base and never
+// exceeding max.
+func Exponential(base, max time.Duration) Policy {
+	return PolicyFunc(func(attempt int, _ time.Duration) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			if d > max/2 { // doubling would reach max or overflow
+				return max
+			}
+			d *= 2
+		}
+
+		if d > max {
+			return max
+		}
+		return d
+	})
+}
+
+// DecorrelatedJitter picks a random delay between base and three times the
+// previous delay, never exceeding max. This spreads out the retries of many
+// clients failing at the same time.
+func DecorrelatedJitter(base, max time.Duration) Policy {
+	return PolicyFunc(func(_ int, prev time.Duration) time.Duration {
+		if prev < base {
+			prev = base
+		}
+
+		upper := prev * 3
+		if upper > max || upper <= 0 {
+			upper = max
+		}
+		if upper <= base {
+			return upper
+		}
+
+		return base + time.Duration(rand.Int63n(int64(upper-base)))
+	})
+}
+
+// Option configures Do.
+type Option func(*options)
+
+type options struct {
+	policy      Policy
+	maxAttempts int
+	maxElapsed  time.Duration
+	retryIf     func(error) bool
+	clock       Clock
+}
+
+// WithPolicy sets the backoff policy. It defaults to exponential backoff
+// starting at 100ms, up to 10s.
+func WithPolicy(p Policy) Option {
+	return func(o *options) {
+		o.policy = p
+	}
+}
+
+// MaxAttempts limits the number of calls, including the first one. It
+// defaults to 5, a value < 1 means no limit.
+func MaxAttempts(n int) Option {
+	return func(o *options) {
+		o.maxAttempts = n
+	}
+}
+
+// MaxElapsed stops retrying if the next attempt would start later than d after
+// the first one.
+func MaxElapsed(d time.Duration) Option {
+	return func(o *options) {
+		o.maxElapsed = d
+	}
+}
+
+// RetryIf only retries errors that f returns true for. By default all errors
+// are retried.
+func RetryIf(f func(error) bool) Option {
+	return func(o *options) {
+		o.retryIf = f
+	}
+}
+
+// WithClock replaces the clock used to measure and wait for the delays.
+func WithClock(c Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// Do calls f until it succeeds and returns its result. It gives up when f
+// returns an error that should not be retried or the limits are reached,
+// returning the last error of f. f is not called once ctx is done, the error
+// of ctx is returned instead.
+func Do[T any](ctx context.Context, f func() (T, error), opts ...Option) Result[T] {
+	o := options{
+		policy:      Exponential(100*time.Millisecond, 10*time.Second),
+		maxAttempts: 5,
+		clock:       realClock{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	start := o.clock.Now()
+	delay := time.Duration(0)
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			if lastErr == nil {
+				return Err[T](err)
+			}
+			return Err[T](fmt.Errorf("%w (last error: %w)", err, lastErr))
+		}
+
+		t, err := f()
+		if err == nil {
+			return OK(t)
+		}
+		lastErr = err
+
+		if o.retryIf != nil && !o.retryIf(err) {
+			return Err[T](err)
+		}
+
+		if o.maxAttempts > 0 && attempt >= o.maxAttempts {
+			return Err[T](err)
+		}
+
+		delay = o.policy.Delay(attempt, delay)
+		if o.maxElapsed > 0 && o.clock.Now().Add(delay).Sub(start) > o.maxElapsed {
+			return Err[T](err)
+		}
+
+		select {
+		case <-o.clock.After(delay):
+		case <-ctx.Done():
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errFlaky = errors.New("flaky")
+
+// fakeClock advances its time by the requested delay instead of sleeping.
+type fakeClock struct {
+	now    time.Time
+	delays []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// failing returns a function that fails n times before it succeeds.
+func failing(n int, calls *int) func() (int, error) {
+	return func() (int, error) {
+		*calls++
+		if *calls <= n {
+			return 0, errFlaky
+		}
+		return *calls, nil
+	}
+}
+
+func equalDelays(a, b []time.Duration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		want   []time.Duration
+	}{
+		{"constant", Constant(time.Second), []time.Duration{time.Second, time.Second, time.Second, time.Second}},
+		{"exponential", Exponential(time.Second, 5*time.Second), []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}},
+		{"exponential from zero", Exponential(0, 10*time.Second), []time.Duration{0, 0, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			calls := 0
+			r := Do(context.Background(), failing(4, &calls), WithPolicy(tt.policy), WithClock(clock))
+
+			if !r.Success() || r.Value() != 5 {
+				t.Fatalf("Do() = %v, %v; want 5", r.Value(), r.Error())
+			}
+			if !equalDelays(clock.delays, tt.want) {
+				t.Fatalf("delays = %v, want %v", clock.delays, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecorrelatedJitter(t *testing.T) {
+	base, max := time.Second, 10*time.Second
+	p := DecorrelatedJitter(base, max)
+
+	prev := time.Duration(0)
+	for attempt := 1; attempt < 100; attempt++ {
+		d := p.Delay(attempt, prev)
+		if d < base || d > max {
+			t.Fatalf("Delay(%d, %v) = %v, want between %v and %v", attempt, prev, d, base, max)
+		}
+		if prev >= base && d > prev*3 {
+			t.Fatalf("Delay(%d, %v) = %v, want at most %v", attempt, prev, d, prev*3)
+		}
+		prev = d
+	}
+}
+
+func TestMaxAttempts(t *testing.T) {
+	calls := 0
+	r := Do(context.Background(), failing(10, &calls), MaxAttempts(3), WithClock(&fakeClock{}))
+
+	if r.Error() != errFlaky {
+		t.Fatalf("Do() error = %v, want %v", r.Error(), errFlaky)
+	}
+	if calls != 3 {
+		t.Fatalf("f called %d times, want 3", calls)
+	}
+}
+
+func TestMaxElapsed(t *testing.T) {
+	clock := &fakeClock{}
+	calls := 0
+	r := Do(context.Background(), failing(10, &calls),
+		WithPolicy(Constant(time.Second)),
+		MaxAttempts(0),
+		MaxElapsed(3500*time.Millisecond),
+		WithClock(clock),
+	)
+
+	if r.Error() != errFlaky {
+		t.Fatalf("Do() error = %v, want %v", r.Error(), errFlaky)
+	}
+	if calls != 4 {
+		t.Fatalf("f called %d times, want 4", calls)
+	}
+	if elapsed := clock.now.Sub(time.Time{}); elapsed != 3*time.Second {
+		t.Fatalf("waited %v, want 3s", elapsed)
+	}
+}
+
+func TestRetryIf(t *testing.T) {
+	errPermanent := errors.New("permanent")
+
+	calls := 0
+	r := Do(context.Background(), func() (int, error) {
+		calls++
+		if calls == 2 {
+			return 0, errPermanent
+		}
+		return 0, errFlaky
+	}, RetryIf(func(err error) bool { return err == errFlaky }), WithClock(&fakeClock{}))
+
+	if r.Error() != errPermanent {
+		t.Fatalf("Do() error = %v, want %v", r.Error(), errPermanent)
+	}
+	if calls != 2 {
+		t.Fatalf("f called %d times, want 2", calls)
+	}
+}
+
+func TestCancelledBeforeFirstAttempt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	r := Do(ctx, failing(0, &calls), WithClock(&fakeClock{}))
+
+	if r.Error() != context.Canceled {
+		t.Fatalf("Do() error = %v, want %v", r.Error(), context.Canceled)
+	}
+	if calls != 0 {
+		t.Fatalf("f called %d times, want 0", calls)
+	}
+}
+
+func TestCancelledBetweenAttempts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	r := Do(ctx, func() (int, error) {
+		calls++
+		cancel()
+		return 0, errFlaky
+	}, MaxAttempts(0), WithClock(&fakeClock{}))
+
+	if !r.Is(context.Canceled) || !r.Is(errFlaky) {
+		t.Fatalf("Do() error = %v, want %v wrapping %v", r.Error(), context.Canceled, errFlaky)
+	}
+	if calls != 1 {
+		t.Fatalf("f called %d times, want 1", calls)
+	}
+}
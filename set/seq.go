@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// All returns an iterator over the unsorted elements of this set.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
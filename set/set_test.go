@@ -0,0 +1,21 @@
+package set
+
+import "testing"
+
+func TestEnumerate(t *testing.T) {
+	s := New(1, 2, 3)
+
+	got := New[int]()
+	for e := s.Enumerate(); e.Next(); {
+		got.Put(e.Value())
+	}
+
+	if got.Len() != s.Len() {
+		t.Fatalf("Enumerate() returned %v, want %v", got.Slice(), s.Slice())
+	}
+	for k := range s {
+		if !got.Has(k) {
+			t.Fatalf("Enumerate() didn't return %d", k)
+		}
+	}
+}
@@ -194,10 +194,16 @@ func Prod[T Number](sl ...T) T {
 }
 
 type sliceEnumerator[T any] struct {
-	sl []T
+	sl      []T
+	started bool
 }
 
 func (e *sliceEnumerator[T]) Next() bool {
+	if !e.started {
+		e.started = true
+		return len(e.sl) > 0
+	}
+
 	if len(e.sl) == 0 {
 		return false
 	}
@@ -216,5 +222,5 @@ func (e *sliceEnumerator[T]) Value() T {
 
 // Enumerate creates an enumerator for sl.
 func Enumerate[T any](sl []T) Enumerable[T] {
-	return &sliceEnumerator[T]{sl}
+	return &sliceEnumerator[T]{sl: sl}
 }
@@ -0,0 +1,32 @@
+package slice
+
+import "testing"
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		name string
+		sl   []int
+	}{
+		{"empty", nil},
+		{"one", []int{1}},
+		{"many", []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for e := Enumerate(tt.sl); e.Next(); {
+				got = append(got, e.Value())
+			}
+
+			if len(got) != len(tt.sl) {
+				t.Fatalf("Enumerate() returned %v, want %v", got, tt.sl)
+			}
+			for i := range got {
+				if got[i] != tt.sl[i] {
+					t.Fatalf("Enumerate() returned %v, want %v", got, tt.sl)
+				}
+			}
+		})
+	}
+}
@@ -1,51 +1,277 @@
 package nox
 
 import (
+	"context"
+	"errors"
 	"runtime"
 	"sync"
 
+	. "github.com/noxer/nox/dot"
 	"github.com/noxer/nox/math"
 )
 
+// Option configures the behavior of the concurrent helpers.
+type Option func(*options)
+
+type options struct {
+	workers    int
+	collectAll bool
+	pool       *Pool
+	repanic    bool
+	observer   Observer
+}
+
+func newOptions(opts []Option) options {
+	o := options{workers: runtime.NumCPU()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.workers < 1 {
+		o.workers = 1
+	}
+
+	return o
+}
+
+// Workers sets the number of workers processing the tasks. It defaults to
+// runtime.NumCPU().
+func Workers(n int) Option {
+	return func(o *options) {
+		o.workers = n
+	}
+}
+
+// CollectAll keeps dispatching tasks after a task returned an error. By
+// default no new tasks are started after the first error.
+func CollectAll() Option {
+	return func(o *options) {
+		o.collectAll = true
+	}
+}
+
+// OnPool runs the tasks on the workers of p instead of starting new goroutines.
+// The number of workers still limits how many tasks of a call are processed at
+// the same time. Tasks are run on the calling goroutine if p has been shut
+// down or has no workers.
+func OnPool(p *Pool) Option {
+	return func(o *options) {
+		o.pool = p
+	}
+}
+
+// Repanic makes ConcurrentCtx panic on the calling goroutine with the
+// *PanicError of the first task that panicked, after all workers have finished.
+// By default the *PanicError is reported as the error of the task.
+func Repanic() Option {
+	return func(o *options) {
+		o.repanic = true
+	}
+}
+
 // ConcurrentNow takes a list of tasks and starts a worker pool to process them
-// with f.
-func ConcurrentNow[T, S any](tasks []T, f func(T) S) []S {
-	return ConcurrentNowN(tasks, f, runtime.NumCPU())
+// with f. If f panics, no new tasks are started and the *PanicError is
+// re-panicked on the calling goroutine after all workers have finished.
+func ConcurrentNow[T, S any](tasks []T, f func(T) S, opts ...Option) []S {
+	return concurrentNow(tasks, f, newOptions(opts))
 }
 
 // ConcurrentNowN takes a list of tasks and starts a worker pool of size
 // workers to process them with f.
-func ConcurrentNowN[T, S any](tasks []T, f func(T) S, workers int) []S {
-	switch len(tasks) {
-	case 0:
+func ConcurrentNowN[T, S any](tasks []T, f func(T) S, workers int, opts ...Option) []S {
+	return concurrentNow(tasks, f, newOptions(append(opts[:len(opts):len(opts)], Workers(workers))))
+}
+
+func concurrentNow[T, S any](tasks []T, f func(T) S, o options) []S {
+	if len(tasks) == 0 {
 		return nil
+	}
 
-	case 1:
-		return []S{f(tasks[0])}
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	t := newTracker(o.observer, len(tasks))
+	results := make([]S, len(tasks))
+	task := func(index int) {
+		start := t.start(index)
+		pe := catch(func() {
+			results[index] = f(tasks[index])
+		})
+		if pe == nil {
+			t.finish(index, start, nil)
+			return
+		}
+
+		t.finish(index, start, pe)
+		cancel(pe)
 	}
 
-	workers = math.Min(len(tasks), workers)
+	if len(tasks) == 1 {
+		task(0)
+	} else {
+		dispatch(ctx, len(tasks), o, task)
+	}
+
+	if pe, ok := context.Cause(ctx).(*PanicError); ok {
+		panic(pe)
+	}
+
+	return results
+}
+
+// ConcurrentCtx takes a list of tasks and starts a worker pool to process them
+// with f. No new tasks are started once ctx is done or, unless CollectAll is
+// given, a task returned an error. Tasks that were never started carry the
+// error of the context. A task that panicked carries a *PanicError. The
+// returned error is the first error returned by a task, or all of them joined
+// if CollectAll is given.
+func ConcurrentCtx[T, S any](ctx context.Context, tasks []T, f func(context.Context, T) (S, error), opts ...Option) ([]Result[S], error) {
+	return concurrentCtx(ctx, tasks, f, newOptions(opts))
+}
+
+// ConcurrentCtxN is like ConcurrentCtx but uses a worker pool of size workers.
+func ConcurrentCtxN[T, S any](ctx context.Context, tasks []T, f func(context.Context, T) (S, error), workers int, opts ...Option) ([]Result[S], error) {
+	return concurrentCtx(ctx, tasks, f, newOptions(append(opts[:len(opts):len(opts)], Workers(workers))))
+}
+
+func concurrentCtx[T, S any](ctx context.Context, tasks []T, f func(context.Context, T) (S, error), o options) ([]Result[S], error) {
+	taskCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		m    sync.Mutex
+		errs []error
+	)
+
+	t := newTracker(o.observer, len(tasks))
+	results := make([]Result[S], len(tasks))
+	started := dispatch(taskCtx, len(tasks), o, func(index int) {
+		var (
+			s   S
+			err error
+		)
+
+		start := t.start(index)
+		pe := catch(func() {
+			s, err = f(taskCtx, tasks[index])
+		})
+		if pe != nil {
+			err = pe
+		}
+		t.finish(index, start, err)
+
+		results[index] = Wrap(s, err)
+		if err == nil {
+			return
+		}
+
+		m.Lock()
+		errs = append(errs, err)
+		m.Unlock()
+
+		if !o.collectAll {
+			cancel(err)
+		}
+	})
+
+	for index := started; index < len(tasks); index++ {
+		results[index] = Err[S](taskCtx.Err())
+	}
+
+	if o.repanic {
+		for _, err := range errs {
+			if pe, ok := err.(*PanicError); ok {
+				panic(pe)
+			}
+		}
+	}
+
+	switch {
+	case len(errs) == 0 && started < len(tasks):
+		return results, ctx.Err()
+	case len(errs) == 0:
+		return results, nil
+	case o.collectAll:
+		return results, errors.Join(errs...)
+	default:
+		return results, errs[0]
+	}
+}
+
+// dispatch hands the indexes 0 to n-1 to the workers, which call f for each of
+// them. It stops handing out indexes once ctx is done and returns the number
+// of started tasks after all workers have finished.
+func dispatch(ctx context.Context, n int, o options, f func(int)) int {
+	workers := math.Min(n, o.workers)
+	if o.pool != nil {
+		return dispatchPool(ctx, n, workers, o.pool, f)
+	}
 
 	wg := &sync.WaitGroup{}
 	wg.Add(workers)
 
-	in := make(chan int, workers)
-	results := make([]S, len(tasks))
+	in := make(chan int)
 	for i := 0; i < workers; i++ {
 		go func() {
 			defer wg.Done()
 			for index := range in {
-				results[index] = f(tasks[index])
+				f(index)
 			}
 		}()
 	}
 
-	go func() {
-		for i := range tasks {
-			in <- i
+	started := 0
+loop:
+	for started < n && ctx.Err() == nil {
+		select {
+		case in <- started:
+			started++
+		case <-ctx.Done():
+			break loop
 		}
-		close(in)
-	}()
+	}
+	close(in)
 
-	return results
+	wg.Wait()
+	return started
+}
+
+// dispatchPool is like dispatch, but runs at most workers tasks at a time on
+// the pool p.
+func dispatchPool(ctx context.Context, n, workers int, p *Pool, f func(int)) int {
+	wg := &sync.WaitGroup{}
+	sem := make(chan struct{}, workers)
+
+	started := 0
+loop:
+	for started < n && ctx.Err() == nil {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		index := started
+		wg.Add(1)
+		task := func() {
+			defer func() { <-sem }()
+			defer wg.Done()
+			f(index)
+		}
+
+		if p.Size() == 0 {
+			// no worker would ever pick up the task
+			task()
+		} else if err := p.enqueue(ctx, task); errors.Is(err, ErrPoolClosed) {
+			task()
+		} else if err != nil {
+			wg.Done()
+			break loop
+		}
+
+		started++
+	}
+
+	wg.Wait()
+	return started
 }
@@ -0,0 +1,122 @@
+package nox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentNowNWaitsForWorkers(t *testing.T) {
+	tasks := make([]int, 100)
+	for i := range tasks {
+		tasks[i] = i
+	}
+
+	results := ConcurrentNowN(tasks, func(i int) int {
+		time.Sleep(time.Millisecond)
+		return i * 2
+	}, 4)
+
+	for i, r := range results {
+		if r != i*2 {
+			t.Fatalf("results[%d] = %d, want %d", i, r, i*2)
+		}
+	}
+}
+
+func TestConcurrentCtxFailFast(t *testing.T) {
+	errFail := errors.New("fail")
+	tasks := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	var calls atomic.Int32
+	results, err := ConcurrentCtxN(context.Background(), tasks, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 3 {
+			return 0, errFail
+		}
+		return i, nil
+	}, 1)
+
+	if err != errFail {
+		t.Fatalf("err = %v, want %v", err, errFail)
+	}
+	if n := calls.Load(); n != 4 {
+		t.Fatalf("f called %d times, want 4", n)
+	}
+
+	for i, r := range results {
+		switch {
+		case i < 3:
+			if !r.Success() || r.Value() != i {
+				t.Errorf("results[%d] = %v, %v; want %d", i, r.Value(), r.Error(), i)
+			}
+		case i == 3:
+			if r.Error() != errFail {
+				t.Errorf("results[3] error = %v, want %v", r.Error(), errFail)
+			}
+		default:
+			if !r.Is(context.Canceled) {
+				t.Errorf("results[%d] error = %v, want %v", i, r.Error(), context.Canceled)
+			}
+		}
+	}
+}
+
+func TestConcurrentCtxCollectAll(t *testing.T) {
+	tasks := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	var calls atomic.Int32
+	results, err := ConcurrentCtxN(context.Background(), tasks, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i%2 == 1 {
+			return 0, fmt.Errorf("odd %d", i)
+		}
+		return i, nil
+	}, 3, CollectAll())
+
+	if n := calls.Load(); n != int32(len(tasks)) {
+		t.Fatalf("f called %d times, want %d", n, len(tasks))
+	}
+
+	for i, r := range results {
+		if i%2 == 0 {
+			if !r.Success() || r.Value() != i {
+				t.Errorf("results[%d] = %v, %v; want %d", i, r.Value(), r.Error(), i)
+			}
+			continue
+		}
+
+		if r.Success() {
+			t.Errorf("results[%d] succeeded, want error", i)
+		}
+		if !errors.Is(err, r.Error()) {
+			t.Errorf("err %v doesn't contain %v", err, r.Error())
+		}
+	}
+}
+
+func TestConcurrentCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	results, err := ConcurrentCtx(ctx, []int{1, 2, 3}, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		return i, nil
+	})
+
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("f called %d times, want 0", n)
+	}
+	for i, r := range results {
+		if !r.Is(context.Canceled) {
+			t.Errorf("results[%d] error = %v, want %v", i, r.Error(), context.Canceled)
+		}
+	}
+}